@@ -1,8 +1,11 @@
 package emailverifier
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 var emailRegex = regexp.MustCompile(emailRegexString)
@@ -12,23 +15,49 @@ type Syntax struct {
 	Username string `json:"username"`
 	Domain   string `json:"domain"`
 	Valid    bool   `json:"valid"`
+	Error    string `json:"error,omitempty"` // why an otherwise well-formed address was rejected, e.g. an invalid IDN domain
+
+	RegistrableDomain string `json:"registrable_domain"` // the public suffix plus one label, e.g. "example.co.uk" for "mail.example.co.uk"
+	PublicSuffix      string `json:"public_suffix"`      // the public suffix of the domain, e.g. "co.uk" for "mail.example.co.uk"
 }
 
 // ParseAddress attempts to parse an email address and return it in the form of an Syntax
+// according to the syntax profile of the verifier
 func (v *Verifier) ParseAddress(email string) Syntax {
-	isAddressValid := IsAddressValid(email)
+	isAddressValid := v.syntaxProfile.match(email)
 	if !isAddressValid {
 		return Syntax{Valid: false}
 	}
 
 	index := strings.LastIndex(email, "@")
-	username := email[:index]
-	domain := strings.ToLower(email[index+1:])
+	// Unicode local parts are compared in NFC form, see RFC 6532 section 3.1
+	username := norm.NFC.String(email[:index])
+	domain := strings.ToLower(norm.NFC.String(email[index+1:]))
+
+	if err := v.syntaxProfile.check(email, username, domain); err != nil {
+		return Syntax{Valid: false, Error: err.Error()}
+	}
+
+	// Internationalized domains must be valid according to UTS #46
+	asciiDomain, err := toASCII(domain)
+	if err != nil {
+		return Syntax{Valid: false, Error: fmt.Sprintf("invalid domain %q: %v", domain, err)}
+	}
+
+	// The Public Suffix List is keyed by ASCII labels, report the result in the form of the passed domain
+	suffix := publicSuffix(asciiDomain)
+	suffixLabels := strings.Count(suffix, ".") + 1
+	var registrable string
+	if registrableDomain(asciiDomain) != "" {
+		registrable = lastLabels(domain, suffixLabels+1)
+	}
 
 	return Syntax{
-		Username: username,
-		Domain:   domain,
-		Valid:    isAddressValid,
+		Username:          username,
+		Domain:            domain,
+		Valid:             isAddressValid,
+		RegistrableDomain: registrable,
+		PublicSuffix:      lastLabels(domain, suffixLabels),
 	}
 }
 
@@ -2,6 +2,8 @@ package emailverifier
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 var samples = []struct {
@@ -16,6 +18,9 @@ var samples = []struct {
 	{mail: "user@gma3il.com", format: true},
 	{mail: "a_b@github.com", format: true},
 	{mail: "abc@доменное.com", format: true},
+	{mail: "abc@xn--d1acufc.xn--p1ai", format: true},
+	{mail: "abc@ab--c.com", format: false},
+	{mail: "abc@exa~mple.com", format: false},
 }
 
 func TestCheckAddressSyntax(t *testing.T) {
@@ -29,3 +34,17 @@ func TestCheckAddressSyntax(t *testing.T) {
 		}
 	}
 }
+
+func TestParseAddress_InvalidIDN(t *testing.T) {
+	address := verifier.ParseAddress("user@ab--c.com")
+	assert.False(t, address.Valid)
+	assert.Contains(t, address.Error, "ab--c.com")
+}
+
+func TestParseAddress_NormalizeUsername(t *testing.T) {
+	// "e" followed by a combining acute accent is normalized to "é"
+	address := verifier.ParseAddress("jose\u0301@example.com")
+	assert.True(t, address.Valid)
+	assert.Equal(t, "jos\u00e9", address.Username)
+	assert.Empty(t, address.Error)
+}
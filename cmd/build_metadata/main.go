@@ -3,12 +3,19 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"os/exec"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/idna"
 )
 
 // writeFile writes content to a file
@@ -25,28 +32,36 @@ func writeFile(filePath string, data []byte) {
 }
 
 type fileInfo struct {
+	list        string
 	path        string
 	varName     string
 	srcPath     string
 	description string
+	compact     bool // generate a compactSet instead of a map, for large lists
 }
 
-func buildMetaDataFile() {
+// buildMetaDataFile generates the embedded lists and returns their versions keyed by list name
+func buildMetaDataFile() map[string]string {
+	versions := make(map[string]string)
 	var files []fileInfo
 	files = append(files,
 		fileInfo{
+			list:        "disposable",
 			path:        "disposable.txt",
 			varName:     "disposableDomains",
 			srcPath:     "../../metadata_disposable.go",
-			description: "// map to store disposable domains data",
+			description: "// sorted disposable domains, one per line, see compactSet",
+			compact:     true,
 		},
 		fileInfo{
+			list:        "free",
 			path:        "free.txt",
 			varName:     "freeDomains",
 			srcPath:     "../../metadata_free.go",
 			description: "// map to store free domains data",
 		},
 		fileInfo{
+			list:        "role",
 			path:        "role.txt",
 			varName:     "roleAccounts",
 			srcPath:     "../../metadata_role.go",
@@ -58,41 +73,329 @@ func buildMetaDataFile() {
 		log.Printf("Building map for: %s\n", f.path)
 		file, err := os.Open(f.path)
 		if err != nil {
-			panic(fmt.Sprintf("open meta data f %s fail: %v ", f, err))
+			panic(fmt.Sprintf("open meta data f %s fail: %v ", f.path, err))
 		}
 
-		output := bytes.Buffer{}
-		output.WriteString("// Code generated by cmd/build_metadata; DO NOT EDIT.\n\n")
-		output.WriteString("package emailverifier\n\n")
-		output.WriteString(f.description + "\n")
-		output.WriteString(fmt.Sprintf("var %s = map[string]bool {\n", f.varName))
-
 		scanner := bufio.NewScanner(file)
 		scanner.Split(bufio.ScanLines)
 
+		var keys []string
 		data := make(map[string]bool)
 		for scanner.Scan() {
 			key := scanner.Text()
+			if f.compact {
+				key = normalizeDomain(key)
+			}
 
 			if !data[key] {
-				output.WriteString("\t")
-				output.WriteString(strconv.Quote(key))
-				output.WriteString(": ")
-				output.WriteString("true")
-				output.WriteString(",\n")
-
+				keys = append(keys, key)
 			}
 			data[key] = true
 		}
-		output.WriteString("}")
 		log.Printf("Read %d mappings in %s\n", len(data), f.path)
 
 		err = file.Close()
 		if err != nil {
 			panic(fmt.Sprintf("close role meta data file %s fail: %v ", f.path, err))
 		}
+
+		output := bytes.Buffer{}
+		output.WriteString("// Code generated by cmd/build_metadata; DO NOT EDIT.\n\n")
+		output.WriteString("package emailverifier\n\n")
+		if f.compact {
+			writeCompactSet(&output, f, keys)
+		} else {
+			writeMap(&output, f, keys)
+		}
 		writeFile(f.srcPath, output.Bytes())
+		versions[f.list] = listVersion(data)
+	}
+	return versions
+}
+
+// writeMap writes the keys as a map literal, in the order they are read
+func writeMap(output *bytes.Buffer, f fileInfo, keys []string) {
+	output.WriteString(f.description + "\n")
+	output.WriteString(fmt.Sprintf("var %s = map[string]bool {\n", f.varName))
+	for _, key := range keys {
+		output.WriteString("\t")
+		output.WriteString(strconv.Quote(key))
+		output.WriteString(": ")
+		output.WriteString("true")
+		output.WriteString(",\n")
+	}
+	output.WriteString("}")
+}
+
+// writeCompactSet writes the sorted keys as a raw string constant, one per line, loaded by newCompactSet.
+// Unlike a map literal the constant costs no memory and no time when the package is initialized.
+func writeCompactSet(output *bytes.Buffer, f fileInfo, keys []string) {
+	sorted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if strings.ContainsAny(key, "`\n") {
+			panic(fmt.Sprintf("invalid entry %q in %s", key, f.path))
+		}
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	output.WriteString(f.description + "\n")
+	output.WriteString(fmt.Sprintf("const %sData = `\n", f.varName))
+	for _, key := range sorted {
+		output.WriteString(key)
+		output.WriteString("\n")
+	}
+	output.WriteString("`\n\n")
+	output.WriteString(fmt.Sprintf("var %s = newCompactSet(%sData)\n", f.varName, f.varName))
+}
+
+// normalizeDomain returns the lower case ASCII form of a domain, the form the lists are looked up with
+func normalizeDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if ascii, err := idna.ToASCII(domain); err == nil {
+		return ascii
+	}
+	return domain
+}
+
+// listVersion returns a short hash identifying the entries of a list regardless of their order,
+// it must match listVersion of the emailverifier package
+func listVersion(data map[string]bool) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		if k != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// buildVersionFile records the version and build timestamp of every embedded list
+func buildVersionFile(versions map[string]string) {
+	names := make([]string, 0, len(versions))
+	for name := range versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	builtAt := time.Now().UTC().Format(time.RFC3339)
+
+	output := bytes.Buffer{}
+	output.WriteString("// Code generated by cmd/build_metadata; DO NOT EDIT.\n\n")
+	output.WriteString("package emailverifier\n\n")
+	output.WriteString("// map to store the versions of the embedded lists\n")
+	output.WriteString("var metadataVersions = map[string]metadataVersion{\n")
+	for _, name := range names {
+		output.WriteString(fmt.Sprintf("\t%s: {version: %s, builtAt: %s},\n",
+			strconv.Quote(name), strconv.Quote(versions[name]), strconv.Quote(builtAt)))
+	}
+	output.WriteString("}")
+
+	writeFile("../../metadata_version.go", output.Bytes())
+}
+
+// buildPublicSuffixFile generates the public suffix rules from the ICANN section of the Public Suffix List,
+// rules are stored in their ASCII form, see https://github.com/publicsuffix/list/wiki/Format.
+// It returns the TLDs of the rules.
+func buildPublicSuffixFile() map[string]bool {
+	const (
+		path    = "public_suffix_list.dat"
+		srcPath = "../../metadata_psl.go"
+	)
+
+	log.Printf("Building map for: %s\n", path)
+	file, err := os.Open(path)
+	if err != nil {
+		panic(fmt.Sprintf("open public suffix list %s fail: %v ", path, err))
+	}
+	defer file.Close()
 
+	output := bytes.Buffer{}
+	output.WriteString("// Code generated by cmd/build_metadata; DO NOT EDIT.\n\n")
+	output.WriteString("package emailverifier\n\n")
+	output.WriteString("// map to store the ICANN rules of the Public Suffix List, see https://publicsuffix.org\n")
+	output.WriteString("var publicSuffixRules = map[string]bool {\n")
+
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanLines)
+
+	var icann bool
+	data := make(map[string]bool)
+	tlds := make(map[string]bool)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.Contains(line, "===BEGIN ICANN DOMAINS==="):
+			icann = true
+			continue
+		case strings.Contains(line, "===END ICANN DOMAINS==="):
+			icann = false
+			continue
+		case !icann || line == "" || strings.HasPrefix(line, "//"):
+			continue
+		}
+
+		// rules are only valid up to the first whitespace
+		rule := strings.Fields(line)[0]
+		prefix := ""
+		switch {
+		case strings.HasPrefix(rule, "!"):
+			prefix, rule = "!", rule[1:]
+		case strings.HasPrefix(rule, "*."):
+			prefix, rule = "*.", rule[2:]
+		}
+		asciiRule, err := idna.ToASCII(rule)
+		if err != nil {
+			panic(fmt.Sprintf("convert public suffix rule %s fail: %v ", rule, err))
+		}
+		key := prefix + strings.ToLower(asciiRule)
+		tlds[key[strings.LastIndex(key, ".")+1:]] = true
+
+		if !data[key] {
+			output.WriteString("\t")
+			output.WriteString(strconv.Quote(key))
+			output.WriteString(": ")
+			output.WriteString("true")
+			output.WriteString(",\n")
+		}
+		data[key] = true
+	}
+	output.WriteString("}")
+	log.Printf("Read %d rules in %s\n", len(data), path)
+
+	writeFile(srcPath, output.Bytes())
+	return tlds
+}
+
+// tldTypeConsts maps the TLD types of the IANA root zone database, and the brand type, to their Go constants
+var tldTypeConsts = map[string]string{
+	"generic":            "TLDGeneric",
+	"country-code":       "TLDCountryCode",
+	"sponsored":          "TLDSponsored",
+	"generic-restricted": "TLDGenericRestricted",
+	"infrastructure":     "TLDInfrastructure",
+	"brand":              "TLDBrand",
+}
+
+// specialUseTLDs are the special-use names listed in the ICANN section of the Public Suffix List,
+// they are not delegated by IANA, see RFC 6761
+var specialUseTLDs = map[string]bool{
+	"onion": true, // RFC 7686
+}
+
+// buildTLDFile generates the TLD tables from the IANA list of TLDs (tlds-alpha-by-domain.txt),
+// the types and managers taken from the IANA root zone database (tld_types.txt)
+// and the hand-maintained list of brand TLDs (tld_brands.txt).
+// TLDs of the Public Suffix List (pslTLDs) missing from the IANA list are added, so that the tables
+// stay complete when the IANA list is older than the Public Suffix List.
+// It returns the version of the TLDs known to TopLevelDomainExists.
+func buildTLDFile(pslTLDs map[string]bool) string {
+	const srcPath = "../../metadata_tld.go"
+
+	file, err := os.Open("tlds-alpha-by-domain.txt")
+	if err != nil {
+		panic(fmt.Sprintf("open tlds-alpha-by-domain.txt fail: %v", err))
+	}
+	defer file.Close()
+
+	var tlds []string
+	var ianaVersion string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "# Version ") {
+			ianaVersion, _, _ = strings.Cut(strings.TrimPrefix(line, "# Version "), ",")
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		tlds = append(tlds, strings.ToLower(line))
+	}
+	ianaCount := len(tlds)
+	for _, tld := range tlds[:ianaCount] {
+		delete(pslTLDs, tld)
+	}
+	for tld := range pslTLDs {
+		if !specialUseTLDs[tld] {
+			tlds = append(tlds, tld)
+		}
+	}
+	sort.Strings(tlds)
+
+	types := make(map[string][2]string) // TLD => type, manager
+	readLines("tld_types.txt", func(line string) {
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) < 2 {
+			panic(fmt.Sprintf("invalid line %q in tld_types.txt", line))
+		}
+		fields = append(fields, "")
+		types[strings.ToLower(fields[0])] = [2]string{fields[1], strings.TrimSpace(fields[2])}
+	})
+	readLines("tld_brands.txt", func(line string) {
+		tld := strings.ToLower(line)
+		types[tld] = [2]string{"brand", types[tld][1]}
+	})
+
+	output := bytes.Buffer{}
+	output.WriteString("// Code generated by cmd/build_metadata; DO NOT EDIT.\n\n")
+	output.WriteString("package emailverifier\n\n")
+	output.WriteString("// tldListVersion is the version of the IANA list of TLDs the tables are generated from\n")
+	output.WriteString(fmt.Sprintf("const tldListVersion = %s\n\n", strconv.Quote(ianaVersion)))
+	output.WriteString("// map to store the type and manager of every TLD\n")
+	output.WriteString("var tldInfos = map[string]TLDInfo{\n")
+
+	known := make(map[string]bool)
+	for _, tld := range tlds {
+		info, ok := types[tld]
+		if !ok {
+			// new TLDs missing from the root zone database dump, IANA only delegates two letter TLDs to countries
+			info[0] = "generic"
+			if len(tld) == 2 {
+				info[0] = "country-code"
+			}
+		}
+		typeConst, ok := tldTypeConsts[info[0]]
+		if !ok {
+			panic(fmt.Sprintf("unknown type %q of TLD %s", info[0], tld))
+		}
+		if info[0] != "infrastructure" {
+			known[tld] = true
+		}
+		output.WriteString(fmt.Sprintf("\t%s: {Name: %s, Type: %s, Manager: %s},\n",
+			strconv.Quote(tld), strconv.Quote(tld), typeConst, strconv.Quote(info[1])))
+	}
+	output.WriteString("}")
+	log.Printf("Read %d TLDs in tlds-alpha-by-domain.txt, %d more in the Public Suffix List\n", ianaCount, len(tlds)-ianaCount)
+
+	writeFile(srcPath, output.Bytes())
+	return listVersion(known)
+}
+
+// readLines calls fn with every line of the file which is neither empty nor a comment
+func readLines(path string, fn func(line string)) {
+	file, err := os.Open(path)
+	if err != nil {
+		panic(fmt.Sprintf("open %s fail: %v", path, err))
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fn(line)
 	}
 }
 
@@ -138,5 +441,8 @@ func updateMetaData() {
 
 func main() {
 	updateMetaData()
-	buildMetaDataFile()
+	versions := buildMetaDataFile()
+	pslTLDs := buildPublicSuffixFile()
+	versions["tld"] = buildTLDFile(pslTLDs)
+	buildVersionFile(versions)
 }
@@ -30,4 +30,16 @@ cat $new ./free.txt \
     | comm -23 - ./disposable.txt > $tmp
 mv $tmp ./free.txt
 
+# 4. update the public suffix list
+curl --silent https://publicsuffix.org/list/public_suffix_list.dat > ./public_suffix_list.dat
+
+# 5. update the TLDs and their types from the IANA root zone database,
+#    brand TLDs are maintained in ./tld_brands.txt as IANA does not publish them
+curl --silent https://data.iana.org/TLD/tlds-alpha-by-domain.txt > ./tlds-alpha-by-domain.txt
+curl --silent https://www.iana.org/domains/root/db \
+    | tr -d '\n' \
+    | sed 's/<tr>/\n/g' \
+    | sed -n 's|.*/domains/root/db/\([^.]*\)\.html.*<td>\([^<]*\)</td>[^<]*<td>\([^<]*\)</td>.*|\1\t\2\t\3|p' \
+    | sed -e 's/&amp;/\&/g' -e 's/[[:space:]]*$//' > ./tld_types.txt
+
 echo 'Complete Updating meta databases!'
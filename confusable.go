@@ -0,0 +1,197 @@
+package emailverifier
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Confusable stores information about homoglyphs found in an email address
+type Confusable struct {
+	MixedScriptUsername bool   `json:"mixed_script_username"` // the username mixes characters from several scripts
+	MixedScriptDomain   bool   `json:"mixed_script_domain"`   // a label of the domain mixes characters from several scripts
+	Lookalike           string `json:"lookalike"`             // the well-known domain which the domain is visually confusable with
+}
+
+// confusables maps characters to the ASCII letter they are visually confusable with.
+// It is a short hand-picked list of the lowercase Cyrillic, Greek, Armenian and Latin letters most often used
+// in homoglyph domains, not the full confusables.txt of Unicode Technical Standard #39,
+// so lookalikes made of other characters are not detected.
+var confusables = map[rune]string{
+	// Cyrillic
+	'а': "a", // U+0430 CYRILLIC SMALL LETTER A
+	'с': "c", // U+0441 CYRILLIC SMALL LETTER ES
+	'ԁ': "d", // U+0501 CYRILLIC SMALL LETTER KOMI DE
+	'е': "e", // U+0435 CYRILLIC SMALL LETTER IE
+	'һ': "h", // U+04BB CYRILLIC SMALL LETTER SHHA
+	'і': "i", // U+0456 CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I
+	'ј': "j", // U+0458 CYRILLIC SMALL LETTER JE
+	'ӏ': "l", // U+04CF CYRILLIC SMALL LETTER PALOCHKA
+	'о': "o", // U+043E CYRILLIC SMALL LETTER O
+	'р': "p", // U+0440 CYRILLIC SMALL LETTER ER
+	'ԛ': "q", // U+051B CYRILLIC SMALL LETTER QA
+	'ѕ': "s", // U+0455 CYRILLIC SMALL LETTER DZE
+	'ѵ': "v", // U+0475 CYRILLIC SMALL LETTER IZHITSA
+	'ԝ': "w", // U+051D CYRILLIC SMALL LETTER WE
+	'х': "x", // U+0445 CYRILLIC SMALL LETTER HA
+	'у': "y", // U+0443 CYRILLIC SMALL LETTER U
+	'ү': "y", // U+04AF CYRILLIC SMALL LETTER STRAIGHT U
+	// Greek
+	'α': "a", // U+03B1 GREEK SMALL LETTER ALPHA
+	'ι': "i", // U+03B9 GREEK SMALL LETTER IOTA
+	'ο': "o", // U+03BF GREEK SMALL LETTER OMICRON
+	'ρ': "p", // U+03C1 GREEK SMALL LETTER RHO
+	'υ': "u", // U+03C5 GREEK SMALL LETTER UPSILON
+	'ν': "v", // U+03BD GREEK SMALL LETTER NU
+	'χ': "x", // U+03C7 GREEK SMALL LETTER CHI
+	'γ': "y", // U+03B3 GREEK SMALL LETTER GAMMA
+	// Armenian
+	'ո': "n", // U+0578 ARMENIAN SMALL LETTER VO
+	'օ': "o", // U+0585 ARMENIAN SMALL LETTER OH
+	'ս': "u", // U+057D ARMENIAN SMALL LETTER SEH
+	'հ': "h", // U+0570 ARMENIAN SMALL LETTER HO
+	'գ': "q", // U+0563 ARMENIAN SMALL LETTER GIM
+	// Latin lookalikes outside of ASCII
+	'ɑ': "a", // U+0251 LATIN SMALL LETTER ALPHA
+	'ɡ': "g", // U+0261 LATIN SMALL LETTER SCRIPT G
+	'ı': "i", // U+0131 LATIN SMALL LETTER DOTLESS I
+	'ɩ': "i", // U+0269 LATIN SMALL LETTER IOTA
+	'ǀ': "l", // U+01C0 LATIN LETTER DENTAL CLICK
+	'ᴏ': "o", // U+1D0F LATIN LETTER SMALL CAPITAL O
+	'ᴜ': "u", // U+1D1C LATIN LETTER SMALL CAPITAL U
+	'ᴠ': "v", // U+1D20 LATIN LETTER SMALL CAPITAL V
+	'ᴡ': "w", // U+1D21 LATIN LETTER SMALL CAPITAL W
+	'ᴢ': "z", // U+1D22 LATIN LETTER SMALL CAPITAL Z
+}
+
+// scripts are the scripts told apart by the mixed-script detection,
+// characters of any other script are reported as a script on their own.
+var scripts = []*unicode.RangeTable{
+	unicode.Latin,
+	unicode.Cyrillic,
+	unicode.Greek,
+	unicode.Armenian,
+	unicode.Georgian,
+	unicode.Cherokee,
+	unicode.Arabic,
+	unicode.Hebrew,
+	unicode.Thai,
+	unicode.Devanagari,
+	unicode.Han,
+	unicode.Hiragana,
+	unicode.Katakana,
+	unicode.Bopomofo,
+	unicode.Hangul,
+}
+
+// allowedScriptSets are the combinations of scripts commonly used together,
+// as in the "Highly Restrictive" level of UTS #39 section 5.2
+var allowedScriptSets = [][]*unicode.RangeTable{
+	{unicode.Latin, unicode.Han, unicode.Hiragana, unicode.Katakana},
+	{unicode.Latin, unicode.Han, unicode.Bopomofo},
+	{unicode.Latin, unicode.Han, unicode.Hangul},
+}
+
+// CheckConfusable checks the username and the domain for homoglyphs,
+// returns nil when the address does not look deceptive
+func (v *Verifier) CheckConfusable(username, domain string) *Confusable {
+	var ret Confusable
+
+	ret.MixedScriptUsername = isMixedScript(username)
+
+	// Punycode domains are checked in their Unicode form
+	if unicodeDomain, err := idnaProfile.ToUnicode(domain); err == nil {
+		domain = unicodeDomain
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if isMixedScript(label) {
+			ret.MixedScriptDomain = true
+			break
+		}
+	}
+
+	if !isASCII(domain) {
+		if s := skeleton(domain); s != domain && v.IsFreeDomain(s) {
+			ret.Lookalike = s
+		}
+	}
+
+	if ret == (Confusable{}) {
+		return nil
+	}
+	return &ret
+}
+
+// skeleton maps a string to its prototype so that two confusable strings have the same skeleton,
+// reference: https://www.unicode.org/reports/tr39/#def-skeleton
+func skeleton(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if prototype, ok := confusables[r]; ok {
+			b.WriteString(prototype)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return norm.NFD.String(b.String())
+}
+
+// isMixedScript reports whether s contains characters from scripts which are not usually used together.
+// Characters common to all scripts, such as digits and punctuation, are ignored.
+func isMixedScript(s string) bool {
+	found := map[*unicode.RangeTable]bool{}
+	var other bool
+	for _, r := range s {
+		if unicode.In(r, unicode.Common, unicode.Inherited) {
+			continue
+		}
+		script := scriptOf(r)
+		if script == nil {
+			other = true
+			continue
+		}
+		found[script] = true
+	}
+
+	switch {
+	case other:
+		return len(found) > 0
+	case len(found) <= 1:
+		return false
+	}
+
+	for _, allowed := range allowedScriptSets {
+		if containsAllScripts(allowed, found) {
+			return false
+		}
+	}
+	return true
+}
+
+// scriptOf returns the script of r, or nil when it is not one of scripts
+func scriptOf(r rune) *unicode.RangeTable {
+	for _, script := range scripts {
+		if unicode.Is(script, r) {
+			return script
+		}
+	}
+	return nil
+}
+
+// containsAllScripts reports whether every script in found is part of allowed
+func containsAllScripts(allowed []*unicode.RangeTable, found map[*unicode.RangeTable]bool) bool {
+	for script := range found {
+		var ok bool
+		for _, a := range allowed {
+			if a == script {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,57 @@
+package emailverifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckConfusable_Lookalike(t *testing.T) {
+	// "gmaіl.com" with a Cyrillic "і"
+	ret := verifier.CheckConfusable("user", "gmaіl.com")
+	assert.Equal(t, &Confusable{MixedScriptDomain: true, Lookalike: "gmail.com"}, ret)
+}
+
+func TestCheckConfusable_LookalikePunycode(t *testing.T) {
+	ret := verifier.CheckConfusable("user", "xn--gmal-n9d.com")
+	assert.Equal(t, &Confusable{MixedScriptDomain: true, Lookalike: "gmail.com"}, ret)
+}
+
+func TestCheckConfusable_MixedScriptUsername(t *testing.T) {
+	// "pаypal" with a Cyrillic "а"
+	ret := verifier.CheckConfusable("pаypal", "example.com")
+	assert.Equal(t, &Confusable{MixedScriptUsername: true}, ret)
+}
+
+func TestCheckConfusable_None(t *testing.T) {
+	assert.Nil(t, verifier.CheckConfusable("user", "gmail.com"))
+	assert.Nil(t, verifier.CheckConfusable("用户", "доменное.com"))
+	assert.Nil(t, verifier.CheckConfusable("user", "例え.jp"))
+}
+
+func TestIsMixedScript(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+		want bool
+	}{
+		{name: "latin", args: "gmail", want: false},
+		{name: "latin with digits", args: "mail-123", want: false},
+		{name: "cyrillic", args: "домен", want: false},
+		{name: "latin and cyrillic", args: "gmaіl", want: true},
+		{name: "latin and greek", args: "gοogle", want: true},
+		{name: "japanese", args: "ドメイン名例abc", want: false},
+		{name: "hangul and cyrillic", args: "한국д", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equalf(t, tt.want, isMixedScript(tt.args), "isMixedScript(%v)", tt.args)
+		})
+	}
+}
+
+func TestSkeleton(t *testing.T) {
+	assert.Equal(t, "gmail.com", skeleton("gmaіl.com"))
+	assert.Equal(t, "paypal.com", skeleton("pаypаl.com"))
+	assert.Equal(t, "example.com", skeleton("example.com"))
+}
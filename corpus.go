@@ -0,0 +1,184 @@
+package emailverifier
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// suggestionCorpus is a set of suggestion targets, indexed for fuzzy search on first use
+type suggestionCorpus struct {
+	domains    map[string]bool
+	popularity map[string]float32 // domain => popularity from 0 to 1, nil for the built-in corpora
+
+	once  sync.Once
+	index *fuzzyIndex
+}
+
+// newSuggestionCorpus creates a corpus of domains
+func newSuggestionCorpus(domains map[string]bool) *suggestionCorpus {
+	return &suggestionCorpus{domains: domains}
+}
+
+// newWeightedCorpus creates a corpus of the domains and of the domains weighted by popularity,
+// the popularity is scaled to the range 0 to 1. The corpus is not weighted when popularity is empty.
+func newWeightedCorpus(domains []string, popularity map[string]float64) *suggestionCorpus {
+	var highest float64
+	for _, weight := range popularity {
+		highest = max(highest, weight)
+	}
+
+	c := &suggestionCorpus{domains: make(map[string]bool, len(domains)+len(popularity))}
+	for _, d := range domains {
+		c.domains[d] = true
+	}
+	if len(popularity) == 0 {
+		return c
+	}
+	c.popularity = make(map[string]float32, len(popularity))
+	for d, weight := range popularity {
+		d = strings.ToLower(strings.TrimSpace(d))
+		if d == "" {
+			continue
+		}
+		c.domains[d] = true
+		if weight > 0 {
+			c.popularity[d] = max(c.popularity[d], float32(weight/highest))
+		}
+	}
+	return c
+}
+
+// search calls fn with every domain within the Levenshtein distance radius of query, in any order
+func (c *suggestionCorpus) search(query string, radius int, fn func(domain string)) {
+	c.once.Do(func() {
+		values := make([]string, 0, len(c.domains))
+		for d := range c.domains {
+			values = append(values, d)
+		}
+		sort.Strings(values)
+		c.index = newFuzzyIndex(values)
+	})
+	c.index.search(query, radius, fn)
+}
+
+// rank returns the value the suggestions of the corpus are sorted by: the similarity,
+// raised by popularityWeight times the popularity of the domain for a weighted corpus
+func (c *suggestionCorpus) rank(domain string, score float32, model *typoModel, popularityWeight float32) float32 {
+	if c.popularity == nil {
+		return model.rank(domain, score)
+	}
+	return score + c.popularity[domain]*popularityWeight
+}
+
+// verifierCorpora are the corpora of the domains suggested by a Verifier.
+// The corpus of whole domains is built from the free domains of the verifier and rebuilt when they change.
+// It is safe for concurrent use.
+type verifierCorpora struct {
+	mu         sync.Mutex
+	popularity map[string]float64 // registered domains, see RegisterSuggestionCorpus
+	revision   uint64             // changes of the free domains the corpus was built from
+	domains    *suggestionCorpus  // nil when it must be rebuilt
+	levels     levelCorpora
+}
+
+// levelCorpora are the corpora misspelled second and top level domains are corrected to
+type levelCorpora struct {
+	secondLevel *suggestionCorpus // such as "gmail" or "yahoo"
+	topLevel    *suggestionCorpus // common top level domains such as "com" or "co.uk"
+}
+
+// newVerifierCorpora creates the default corpora
+func newVerifierCorpora() *verifierCorpora {
+	return &verifierCorpora{
+		levels: levelCorpora{secondLevel: secondLevelDomainCorpus, topLevel: topLevelDomainCorpus},
+	}
+}
+
+// domainCorpus returns the corpus of the whole domains suggested by v
+func (v *Verifier) domainCorpus() *suggestionCorpus {
+	c := v.corpora
+	revision := v.freeDomains.changes()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.domains != nil && c.revision == revision {
+		return c.domains
+	}
+	if revision == 0 && len(c.popularity) == 0 {
+		// the embedded free domains, the corpus is shared by all verifiers
+		c.domains = freeDomainCorpus
+	} else {
+		c.domains = newWeightedCorpus(suggestableDomains(v.freeDomains.entries()), c.popularity)
+	}
+	c.revision = revision
+	return c.domains
+}
+
+// suggestableDomains returns the entries which can be suggested as they are, without wildcard entries
+// such as "*.example.com" nor entries which are not domains
+func suggestableDomains(entries []string) []string {
+	domains := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry, "*.") && validateDomainEntry(entry) == nil {
+			domains = append(domains, entry)
+		}
+	}
+	return domains
+}
+
+// levelCorpora returns the corpora of the second and top level domains suggested by v
+func (v *Verifier) levelCorpora() levelCorpora {
+	v.corpora.mu.Lock()
+	defer v.corpora.mu.Unlock()
+	return v.corpora.levels
+}
+
+// RegisterSuggestionCorpus registers domains weighted by popularity, such as signup counts, as suggestion targets.
+// Among the domains similar to a misspelled one, the popular ones are suggested first even if another is closer,
+// see SuggestionConfig.PopularityWeight. The free domains of the verifier are still suggested, with no popularity.
+// Registering an empty corpus restores the default suggestions.
+func (v *Verifier) RegisterSuggestionCorpus(popularity map[string]float64) *Verifier {
+	v.corpora.mu.Lock()
+	defer v.corpora.mu.Unlock()
+	v.corpora.popularity = popularity
+	v.corpora.domains = nil
+	return v
+}
+
+// ReplaceSuggestionSecondLevelDomains replaces the second level domains, such as "gmail" or "yahoo",
+// which misspelled second level domains are corrected to. Empty domains restore the embedded ones.
+func (v *Verifier) ReplaceSuggestionSecondLevelDomains(domains []string) *Verifier {
+	corpus := secondLevelDomainCorpus
+	if len(domains) > 0 {
+		corpus = newSuggestionCorpus(normalizedSet(domains))
+	}
+	v.corpora.mu.Lock()
+	defer v.corpora.mu.Unlock()
+	v.corpora.levels.secondLevel = corpus
+	return v
+}
+
+// ReplaceSuggestionTopLevelDomains replaces the common top level domains, such as "com" or "co.uk",
+// which misspelled top level domains are corrected to first. Empty domains restore the embedded ones.
+func (v *Verifier) ReplaceSuggestionTopLevelDomains(tlds []string) *Verifier {
+	corpus := topLevelDomainCorpus
+	if len(tlds) > 0 {
+		corpus = newSuggestionCorpus(normalizedSet(tlds))
+	}
+	v.corpora.mu.Lock()
+	defer v.corpora.mu.Unlock()
+	v.corpora.levels.topLevel = corpus
+	return v
+}
+
+// normalizedSet returns the set of the lower case entries, without the empty ones
+func normalizedSet(entries []string) map[string]bool {
+	set := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		if e = trimLower(e); e != "" {
+			set[e] = true
+		}
+	}
+	return set
+}
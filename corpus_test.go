@@ -0,0 +1,117 @@
+package emailverifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterSuggestionCorpus_PrefersPopular(t *testing.T) {
+	verifier := NewVerifier()
+	corpus := map[string]float64{"bigcorpmail.com": 1000, "bigcorpmall.com": 1}
+
+	// bigcorpmall.com is one edit closer
+	verifier.RegisterSuggestionCorpus(map[string]float64{"bigcorpmail.com": 1, "bigcorpmall.com": 1})
+	assert.Equal(t, "bigcorpmall.com", verifier.SuggestDomain("bigcorpmoll.com"))
+
+	verifier.RegisterSuggestionCorpus(corpus)
+	suggestions := verifier.SuggestDomains("bigcorpmoll.com")
+	assert.Equal(t, []string{"bigcorpmail.com", "bigcorpmall.com"}, suggestedDomains(suggestions))
+	assert.Greater(t, suggestions[1].Score, suggestions[0].Score)
+
+	// a small popularity weight lets the closest domain win again
+	verifier.ConfigureSuggestions(SuggestionConfig{PopularityWeight: 0.01})
+	assert.Equal(t, "bigcorpmall.com", verifier.SuggestDomain("bigcorpmoll.com"))
+}
+
+func TestRegisterSuggestionCorpus_KeepsFreeDomains(t *testing.T) {
+	verifier := NewVerifier().RegisterSuggestionCorpus(map[string]float64{" BigCorpMail.com ": 10})
+
+	assert.Equal(t, "bigcorpmail.com", verifier.SuggestDomain("bigcorpmial.com"))
+	assert.Equal(t, "gmail.com", verifier.SuggestDomain("gmaill.com"))
+	assert.Empty(t, verifier.SuggestDomain("bigcorpmail.com"))
+}
+
+func TestRegisterSuggestionCorpus_Reset(t *testing.T) {
+	verifier := NewVerifier().RegisterSuggestionCorpus(map[string]float64{"bigcorpmail.com": 10})
+	assert.Equal(t, "bigcorpmail.com", verifier.SuggestDomain("bigcorpmial.com"))
+
+	verifier.RegisterSuggestionCorpus(nil)
+	assert.Empty(t, verifier.SuggestDomain("bigcorpmial.com"))
+}
+
+func TestNewWeightedCorpus(t *testing.T) {
+	c := newWeightedCorpus([]string{"gmail.com"}, map[string]float64{"a.com": 50, "b.com": 200, "c.com": -1, "": 3})
+
+	assert.Equal(t, float32(0.25), c.popularity["a.com"])
+	assert.Equal(t, float32(1), c.popularity["b.com"])
+	assert.Zero(t, c.popularity["c.com"])
+	assert.True(t, c.domains["c.com"])
+	assert.False(t, c.domains[""])
+	assert.True(t, c.domains["gmail.com"])
+	assert.Equal(t, float32(0.9), c.rank("gmail.com", 0.9, levenshteinModel, 0.15))
+	assert.InDelta(t, 1.05, c.rank("b.com", 0.9, levenshteinModel, 0.15), 1e-6)
+}
+
+func TestNewWeightedCorpus_NoPopularity(t *testing.T) {
+	c := newWeightedCorpus([]string{"gmail.com"}, nil)
+
+	assert.True(t, c.domains["gmail.com"])
+	assert.Nil(t, c.popularity)
+}
+
+func TestDomainCorpus_FollowsFreeDomains(t *testing.T) {
+	verifier := NewVerifier()
+	assert.Same(t, freeDomainCorpus, verifier.domainCorpus())
+	assert.Empty(t, verifier.SuggestDomain("bigcorpmial.com"))
+
+	verifier.AddFreeDomains([]string{"bigcorpmail.com"})
+	assert.Equal(t, "bigcorpmail.com", verifier.SuggestDomain("bigcorpmial.com"))
+
+	verifier.RemoveFreeDomains([]string{"bigcorpmail.com"})
+	assert.Empty(t, verifier.SuggestDomain("bigcorpmial.com"))
+
+	verifier.ReplaceFreeDomains([]string{"bigcorpmail.com"}).RegisterSuggestionCorpus(map[string]float64{"tenantmail.com": 1})
+	assert.Equal(t, "bigcorpmail.com", verifier.SuggestDomain("bigcorpmial.com"))
+	assert.Equal(t, "tenantmail.com", verifier.SuggestDomain("tenantmial.com"))
+	assert.False(t, verifier.domainCorpus().domains["hotmail.com"])
+
+	// other verifiers keep the embedded free domains
+	assert.Same(t, freeDomainCorpus, NewVerifier().domainCorpus())
+}
+
+func TestDomainCorpus_SkipsWildcardEntries(t *testing.T) {
+	verifier := NewVerifier().AddFreeDomains([]string{"*.bigcorpmail.com", "localmail", "tenantmail.com"})
+	assert.Equal(t, "tenantmail.com", verifier.SuggestDomain("tenantmial.com"))
+	assert.Empty(t, verifier.SuggestDomain("*.bigcorpmial.com"))
+	assert.Empty(t, verifier.SuggestDomain("localmial"))
+
+	domains := verifier.domainCorpus().domains
+	assert.False(t, domains["*.bigcorpmail.com"])
+	assert.False(t, domains["localmail"])
+	// the wildcard entry still marks the subdomains as free
+	assert.True(t, verifier.IsFreeDomain("eu.bigcorpmail.com"))
+}
+
+func TestReplaceSuggestionLevelDomains(t *testing.T) {
+	verifier := NewVerifier()
+	assert.Equal(t, "bigcrop.com", verifier.SuggestDomain("bigcrop.co"))
+	assert.Equal(t, "bigcorp.com", verifier.SuggestDomain("bigcorp.co"))
+
+	verifier.ReplaceSuggestionSecondLevelDomains([]string{" BigCorp "}).ReplaceSuggestionTopLevelDomains([]string{"co"})
+	assert.Equal(t, "bigcorp.co", verifier.SuggestDomain("bigcrop.co"))
+	assert.Empty(t, verifier.SuggestDomain("bigcorp.co"))
+
+	verifier.ReplaceSuggestionSecondLevelDomains(nil).ReplaceSuggestionTopLevelDomains(nil)
+	assert.Equal(t, "bigcrop.com", verifier.SuggestDomain("bigcrop.co"))
+	assert.Equal(t, "bigcorp.com", verifier.SuggestDomain("bigcorp.co"))
+}
+
+// suggestedDomains returns the domains of suggestions
+func suggestedDomains(suggestions []DomainSuggestion) []string {
+	domains := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		domains = append(domains, s.Domain)
+	}
+	return domains
+}
@@ -13,6 +13,10 @@ const (
 	ErrServerUnavailable = "Mail server is unavailable"
 	ErrBlocked           = "Blocked by mail server"
 
+	// ErrSMTPUTF8Unsupported is returned when an internationalized address
+	// cannot be verified because the mail server lacks the SMTPUTF8 extension
+	ErrSMTPUTF8Unsupported = "unverifiable: server lacks SMTPUTF8"
+
 	// RCPT Errors
 	ErrTryAgainLater           = "Try again later"
 	ErrFullInbox               = "Recipient out of disk space"
@@ -0,0 +1,151 @@
+package emailverifier
+
+import "sync"
+
+// gramPadStart and gramPadEnd pad strings so that their first and last characters are part of two bigrams
+const (
+	gramPadStart = '\x02'
+	gramPadEnd   = '\x03'
+)
+
+// bigram is a pair of consecutive characters
+type bigram [2]rune
+
+// fuzzyIndex is an inverted index of the bigrams of strings, it finds the strings within a Levenshtein distance
+// of a query without comparing the query to every string.
+// An edit destroys at most two bigrams, so a string within distance k of the query
+// shares at least all but 2k of the distinct bigrams of the query (count filter).
+type fuzzyIndex struct {
+	values   []string
+	runes    [][]rune
+	postings map[bigram][]int32 // bigram => indexes of the values containing it
+
+	counters sync.Pool // *[]uint16 counting the bigrams shared with a query
+}
+
+// newFuzzyIndex indexes values
+func newFuzzyIndex(values []string) *fuzzyIndex {
+	x := &fuzzyIndex{
+		values:   values,
+		runes:    make([][]rune, len(values)),
+		postings: make(map[bigram][]int32),
+	}
+	for i, v := range values {
+		x.runes[i] = []rune(v)
+		for _, g := range bigrams(x.runes[i]) {
+			x.postings[g] = append(x.postings[g], int32(i))
+		}
+	}
+	x.counters.New = func() any {
+		counts := make([]uint16, len(values))
+		return &counts
+	}
+	return x
+}
+
+// bigrams returns the distinct bigrams of the padded s
+func bigrams(s []rune) []bigram {
+	grams := make([]bigram, 0, len(s)+1)
+	prev := rune(gramPadStart)
+	for i := 0; i <= len(s); i++ {
+		r := rune(gramPadEnd)
+		if i < len(s) {
+			r = s[i]
+		}
+		g := bigram{prev, r}
+		prev = r
+		found := false
+		for _, seen := range grams {
+			if seen == g {
+				found = true
+				break
+			}
+		}
+		if !found {
+			grams = append(grams, g)
+		}
+	}
+	return grams
+}
+
+// search calls fn with every value within radius of query
+func (x *fuzzyIndex) search(query string, radius int, fn func(value string)) {
+	runes := []rune(query)
+	grams := bigrams(runes)
+	row := make([]int, 0, 64)
+	check := func(i int) {
+		candidate := x.runes[i]
+		if abs(len(candidate)-len(runes)) > radius {
+			return
+		}
+		row = growRow(row, len(candidate))
+		if levenshtein(runes, candidate, row) <= radius {
+			fn(x.values[i])
+		}
+	}
+
+	minShared := len(grams) - 2*radius
+	if minShared <= 0 {
+		// short queries may share no bigram at all with similar strings
+		for i := range x.values {
+			check(i)
+		}
+		return
+	}
+
+	countsPtr := x.counters.Get().(*[]uint16)
+	defer x.counters.Put(countsPtr)
+	counts := *countsPtr
+
+	var touched []int32
+	for _, g := range grams {
+		for _, i := range x.postings[g] {
+			if counts[i] == 0 {
+				touched = append(touched, i)
+			}
+			counts[i]++
+		}
+	}
+	for _, i := range touched {
+		if int(counts[i]) >= minShared {
+			check(int(i))
+		}
+		counts[i] = 0
+	}
+}
+
+// levenshtein returns the Levenshtein distance between a and b, row is a buffer of at least len(b)+1 ints
+func levenshtein(a, b []rune, row []int) int {
+	row = row[:len(b)+1]
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		diag := row[0]
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			next := min(row[j]+1, row[j-1]+1, diag+cost)
+			diag, row[j] = row[j], next
+		}
+	}
+	return row[len(b)]
+}
+
+// growRow returns row, grown to hold at least n+1 ints
+func growRow(row []int, n int) []int {
+	if cap(row) < n+1 {
+		return make([]int, n+1, 2*n+2)
+	}
+	return row[:n+1]
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
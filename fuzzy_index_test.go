@@ -0,0 +1,126 @@
+package emailverifier
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// linearSimilarDomains is findSimilarDomains without index, checking every domain of the corpus
+func linearSimilarDomains(domain string, domains map[string]bool, threshold float32, model *typoModel) []string {
+	var similar []string
+	for d := range domains {
+		if d != domain && model.similarity(domain, d) >= threshold {
+			similar = append(similar, d)
+		}
+	}
+	sort.Strings(similar)
+	return similar
+}
+
+// randomDomains returns n pseudo-random domains
+func randomDomains(n int) map[string]bool {
+	r := rand.New(rand.NewSource(1))
+	tlds := []string{"com", "net", "org", "de", "co.uk", "io"}
+	domains := make(map[string]bool, n)
+	for len(domains) < n {
+		label := make([]byte, 4+r.Intn(10))
+		for i := range label {
+			label[i] = alphanumeric[r.Intn(26)]
+		}
+		domains[string(label)+"."+tlds[r.Intn(len(tlds))]] = true
+	}
+	return domains
+}
+
+func TestFuzzyIndex_Search(t *testing.T) {
+	values := []string{"gmail.com", "gmx.com", "hotmail.com", "ymail.com", "mail.com", "gmail.co", "g.co"}
+	index := newFuzzyIndex(values)
+
+	for _, query := range []string{"gmial.com", "gmai.com", "g.c", ""} {
+		for radius := 0; radius <= 4; radius++ {
+			var got, want []string
+			index.search(query, radius, func(v string) { got = append(got, v) })
+			for _, v := range values {
+				if levenshtein([]rune(query), []rune(v), make([]int, len(v)+1)) <= radius {
+					want = append(want, v)
+				}
+			}
+			assert.ElementsMatch(t, want, got, "query %q radius %d", query, radius)
+		}
+	}
+}
+
+func TestBigrams(t *testing.T) {
+	assert.Equal(t, []bigram{{gramPadStart, 'a'}, {'a', 'a'}, {'a', gramPadEnd}}, bigrams([]rune("aaa")))
+	assert.Equal(t, []bigram{{gramPadStart, gramPadEnd}}, bigrams(nil))
+}
+
+func TestFindSimilarDomains_MatchesLinearScan(t *testing.T) {
+	corpus := newSuggestionCorpus(freeDomains)
+	queries := []string{"gmai.com", "gmial.com", "hotmial.com", "yaho.co.uk", "outlok.com", "protonmial.com", "webb.de", "x.io"}
+
+	for _, layout := range []KeyboardLayout{"", KeyboardQWERTY} {
+		model := typoModelFor(layout)
+		for _, threshold := range []float32{0.6, 0.82, 0.9} {
+			for _, q := range queries {
+				t.Run(fmt.Sprintf("%s/%v/%s", layout, threshold, q), func(t *testing.T) {
+					similar, _ := findSimilarDomains(q, corpus, threshold, model, defaultPopularityWeight)
+					var got []string
+					for _, s := range similar {
+						if s.value != q {
+							got = append(got, s.value)
+						}
+					}
+					sort.Strings(got)
+					assert.Equal(t, linearSimilarDomains(q, freeDomains, threshold, model), got)
+				})
+			}
+		}
+	}
+}
+
+func TestTypoModel_SearchRadius(t *testing.T) {
+	assert.Equal(t, 1, levenshteinModel.searchRadius(9, 0.82))
+	assert.Equal(t, -1, typoModelFor(KeyboardQWERTY).searchRadius(9, 0.82))
+	assert.Equal(t, 2, typoModelFor(KeyboardQWERTY).searchRadius(9, 0.95))
+	assert.Equal(t, -1, typoModelFor(KeyboardQWERTY).searchRadius(9, 0.4))
+	assert.Equal(t, -1, levenshteinModel.searchRadius(9, 0))
+}
+
+func BenchmarkFindSimilarDomains(b *testing.B) {
+	corpora := []struct {
+		name    string
+		domains map[string]bool
+	}{
+		{"free", freeDomains},
+		{"100k", randomDomains(100_000)},
+	}
+	queries := []string{"gmial.com", "hotmial.com", "yahooo.com", "outlok.com"}
+
+	for _, c := range corpora {
+		corpus := newSuggestionCorpus(c.domains)
+		corpus.search("", 0, func(string) {}) // build the index beforehand
+
+		for _, layout := range []KeyboardLayout{"", KeyboardQWERTY} {
+			model := typoModelFor(layout)
+			name := string(layout)
+			if name == "" {
+				name = "levenshtein"
+			}
+			b.Run(c.name+"/"+name+"/linear", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					linearSimilarDomains(queries[i%len(queries)], c.domains, domainThreshold, model)
+				}
+			})
+			b.Run(c.name+"/"+name+"/index", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					findSimilarDomains(queries[i%len(queries)], corpus, domainThreshold, model, defaultPopularityWeight)
+				}
+			})
+		}
+	}
+}
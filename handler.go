@@ -1,69 +1,211 @@
 package emailverifier
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 )
 
-// updateDisposableDomains gets domains data from source's URL
-func updateDisposableDomains(source string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	req, err := http.NewRequest("GET", source, nil)
-	if err != nil {
-		return err
+const (
+	// defaultUpdateInterval is how often lists are updated by default
+	defaultUpdateInterval = 24 * time.Hour
+	// updateTimeout is the timeout for fetching a single list
+	updateTimeout = 30 * time.Second
+	// minUpdateRatio is the minimum size of an updated list relative to its current size,
+	// updates shrinking a list by more than 90% are refused as the source is most likely broken
+	minUpdateRatio = 0.1
+)
+
+// AutoUpdateConfig configures the automatic update of the metadata lists of a Verifier
+type AutoUpdateConfig struct {
+	// Interval between two updates, defaults to 24 hours
+	Interval time.Duration
+	// Sources maps list names (ListDisposable, ListFree, ListRole or ListTLD) to the URL they are updated from,
+	// lists without a source are not updated. Defaults to DefaultUpdateSources.
+	// Sources are either a JSON array or plain text with one entry per line, see LoadList.
+	Sources map[string]string
+	// Jitter is the maximum random delay added to every interval, so that many instances do not update at once
+	Jitter time.Duration
+	// RetryInterval is the delay before retrying a failed update, it is doubled for every consecutive failure
+	// up to Interval. Defaults to one minute.
+	RetryInterval time.Duration
+	// OnUpdate is called after every update attempt of a list, optional
+	OnUpdate func(UpdateEvent)
+}
+
+// UpdateEvent reports the outcome of an update attempt of a list
+type UpdateEvent struct {
+	List     string // name of the list
+	Source   string // URL the list was fetched from
+	Modified bool   // whether the list has been replaced, false when the source is not modified or on error
+	Entries  int    // number of entries fetched from the source
+	Invalid  int    // number of invalid entries which have been skipped
+	Err      error  // why the update failed, nil on success
+}
+
+// DefaultUpdateSources returns the sources lists are updated from by default
+func DefaultUpdateSources() map[string]string {
+	return map[string]string{
+		ListDisposable: disposableDataURL,
+		ListTLD:        tldDataURL,
 	}
+}
 
-	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return err
+// updater updates the lists of a Verifier from their sources
+type updater struct {
+	verifier *Verifier
+	client   *http.Client
+	sources  []*listSource
+	onUpdate func(UpdateEvent)
+}
+
+// listSource is the source of a list, it remembers the validators of the last response for conditional requests
+type listSource struct {
+	list         string
+	url          string
+	etag         string
+	lastModified string
+}
+
+// newUpdater creates an updater for the lists of v
+func newUpdater(v *Verifier, config AutoUpdateConfig) *updater {
+	sources := config.Sources
+	if sources == nil {
+		sources = DefaultUpdateSources()
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("get disposable domains from %s with status_code: %d", source, resp.StatusCode)
+	u := &updater{
+		verifier: v,
+		client:   v.listClient,
+		onUpdate: config.OnUpdate,
+	}
+	for name, url := range sources {
+		src := &listSource{list: name, url: url}
+		// continue with conditional requests where the snapshot left off
+		if v.snapshots != nil {
+			if snap, ok := v.snapshots.latest(name); ok && snap.Source == url {
+				src.etag = snap.ETag
+				src.lastModified = snap.LastModified
+			}
+		}
+		u.sources = append(u.sources, src)
 	}
+	return u
+}
 
-	var domains []string
+// run updates all lists, errors are reported via the OnUpdate callback and returned joined
+func (u *updater) run(ctx context.Context) error {
+	var errs []error
+	for _, src := range u.sources {
+		event := u.update(ctx, src)
+		if u.onUpdate != nil {
+			u.onUpdate(event)
+		}
+		if event.Err != nil {
+			errs = append(errs, event.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// update fetches the source and updates its list when the source has been modified
+func (u *updater) update(ctx context.Context, src *listSource) UpdateEvent {
+	event := UpdateEvent{List: src.list, Source: src.url}
 
-	content, err := io.ReadAll(resp.Body)
+	l, validate, err := u.verifier.lookupList(src.list)
 	if err != nil {
-		return err
+		event.Err = err
+		return event
 	}
 
-	if len(content) == 0 {
-		return nil
+	content, next, notModified, err := u.fetch(ctx, src)
+	if err != nil || notModified {
+		event.Err = err
+		return event
 	}
 
-	if err = json.Unmarshal(content, &domains); err != nil {
-		return err
+	entries, lineErrs, err := parseList(bytes.NewReader(content), validate)
+	if err != nil {
+		event.Err = fmt.Errorf("read %s list from %s: %w", src.list, src.url, err)
+		return event
 	}
+	event.Entries = len(entries)
+	event.Invalid = len(lineErrs)
 
-	newDomains := make(map[string]struct{})
-	for _, v := range domains {
-		newDomains[v] = struct{}{}
+	if size := l.size(); float64(len(entries)) < float64(size)*minUpdateRatio {
+		event.Err = fmt.Errorf("refuse to update %s list from %s: got %d entries, currently %d", src.list, src.url, len(entries), size)
+		return event
 	}
 
-	// clear up invalid disposable domains
-	disposableSyncDomains.Range(func(key, value interface{}) bool {
-		if _, exists := newDomains[key.(string)]; !exists {
-			disposableSyncDomains.Delete(key)
+	fetchedAt := time.Now()
+	l.update(entries, src.url, fetchedAt)
+	event.Modified = true
+	// only the validators of an applied list are sent back, a refused list is fetched in full again
+	src.etag, src.lastModified = next.etag, next.lastModified
+
+	if u.verifier.snapshots != nil {
+		err = u.verifier.snapshots.save(snapshot{
+			SnapshotInfo: SnapshotInfo{
+				List:      src.list,
+				Source:    src.url,
+				FetchedAt: fetchedAt,
+				Entries:   len(entries),
+			},
+			ETag:         src.etag,
+			LastModified: src.lastModified,
+			Items:        entries,
+		})
+		if err != nil {
+			event.Err = fmt.Errorf("save snapshot of %s list: %w", src.list, err)
 		}
-		return true
-	})
+	}
+	return event
+}
+
+// listValidators are the validators of a response, sent back in conditional requests
+type listValidators struct {
+	etag         string
+	lastModified string
+}
+
+// fetch gets the content of the source and its validators,
+// notModified is true when the content has not changed since the last fetch
+func (u *updater) fetch(ctx context.Context, src *listSource) (content []byte, next listValidators, notModified bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src.url, nil)
+	if err != nil {
+		return nil, next, false, err
+	}
+	if src.etag != "" {
+		req.Header.Set("If-None-Match", src.etag)
+	}
+	if src.lastModified != "" {
+		req.Header.Set("If-Modified-Since", src.lastModified)
+	}
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, next, false, err
+	}
+	defer resp.Body.Close()
 
-	// update new domain data
-	for _, d := range domains {
-		disposableSyncDomains.Store(d, struct{}{})
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return nil, next, true, nil
+	default:
+		return nil, next, false, fmt.Errorf("get %s list from %s with status_code: %d", src.list, src.url, resp.StatusCode)
 	}
 
-	// add additionalDisposableDomains again
-	for d := range additionalDisposableDomains {
-		disposableSyncDomains.Store(d, struct{}{})
+	content, err = readLimited(resp.Body, maxListSize)
+	if err != nil {
+		return nil, next, false, fmt.Errorf("read %s list from %s: %w", src.list, src.url, err)
 	}
-	return nil
+
+	next = listValidators{etag: resp.Header.Get("ETag"), lastModified: resp.Header.Get("Last-Modified")}
+	return content, next, false, nil
 }
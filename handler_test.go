@@ -1,18 +1,29 @@
 package emailverifier
 
 import (
+	"context"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/h2non/gock.v1"
 )
 
+// updateDisposable runs a single update of the disposable domains of v from source
+func updateDisposable(v *Verifier, source string) UpdateEvent {
+	u := newUpdater(v, AutoUpdateConfig{Sources: map[string]string{ListDisposable: source}})
+	return u.update(context.Background(), u.sources[0])
+}
+
 func TestUpdateDisposableDomainsOK(t *testing.T) {
-	assert.False(t, verifier.IsDisposable("a.org"))
-	assert.False(t, verifier.IsDisposable("b.com"))
+	v := NewVerifier().ReplaceDisposableDomains([]string{"0009827.com", "c.net", "d.net", "e.net"})
+	assert.False(t, v.IsDisposable("a.org"))
+	assert.False(t, v.IsDisposable("b.com"))
 
-	assert.True(t, verifier.IsDisposable("0009827.com"))
+	assert.True(t, v.IsDisposable("0009827.com"))
 
 	mockResp := []string{"a.org", "b.com", "zzjbfwqi.shop", "dbbd8.club"}
 	defer gock.Off()
@@ -21,18 +32,37 @@ func TestUpdateDisposableDomainsOK(t *testing.T) {
 		Reply(http.StatusOK).
 		JSON(mockResp)
 
-	err := updateDisposableDomains(disposableDataURL)
-	assert.NoError(t, err)
-	assert.True(t, verifier.IsDisposable("a.org"))
-	assert.True(t, verifier.IsDisposable("b.com"))
-	assert.False(t, verifier.IsDisposable("c.net"))
-	assert.False(t, verifier.IsDisposable("0009827.com"))
+	event := updateDisposable(v, disposableDataURL)
+	assert.NoError(t, event.Err)
+	assert.True(t, event.Modified)
+	assert.Equal(t, 4, event.Entries)
+	assert.True(t, v.IsDisposable("a.org"))
+	assert.True(t, v.IsDisposable("b.com"))
+	assert.False(t, v.IsDisposable("c.net"))
+	assert.False(t, v.IsDisposable("0009827.com"))
+}
+
+func TestUpdateDisposableDomainsOK_KeepsAddedDomains(t *testing.T) {
+	v := NewVerifier().
+		ReplaceDisposableDomains([]string{"a.org"}).
+		AddDisposableDomains([]string{"custom.example"})
+
+	defer gock.Off()
+	gock.New("https://raw.githubusercontent.com").
+		Get("/disposable/disposable-email-domains/master/domains.json").
+		Reply(http.StatusOK).
+		JSON([]string{"b.com"})
+
+	event := updateDisposable(v, disposableDataURL)
+	assert.NoError(t, event.Err)
+	assert.True(t, v.IsDisposable("b.com"))
+	assert.True(t, v.IsDisposable("custom.example"))
 }
 
 func TestUpdateDisposableDomainsFailed_NoSuchHost(t *testing.T) {
-	err := updateDisposableDomains("http://abcmockxyz.aaa")
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "no such host")
+	event := updateDisposable(NewVerifier(), "http://abcmockxyz.aaa")
+	assert.Error(t, event.Err)
+	assert.Contains(t, event.Err.Error(), "no such host")
 }
 
 func TestUpdateDisposableDomainsFailed_StatusNotFound(t *testing.T) {
@@ -41,8 +71,8 @@ func TestUpdateDisposableDomainsFailed_StatusNotFound(t *testing.T) {
 		Get("/disposable/disposable-email-domains/master/domains.json").
 		Reply(http.StatusNotFound)
 
-	err := updateDisposableDomains(disposableDataURL)
-	assert.Error(t, err, "get disposable domains from https://raw.githubusercontent.com/disposable/disposable-email-domains/master/domains.json with status_code: 404")
+	event := updateDisposable(NewVerifier(), disposableDataURL)
+	assert.EqualError(t, event.Err, "get disposable list from https://raw.githubusercontent.com/disposable/disposable-email-domains/master/domains.json with status_code: 404")
 }
 
 func TestUpdateDisposableDomainsFailed_StatusInternalError(t *testing.T) {
@@ -51,8 +81,8 @@ func TestUpdateDisposableDomainsFailed_StatusInternalError(t *testing.T) {
 		Get("/disposable/disposable-email-domains/master/domains.json").
 		Reply(http.StatusInternalServerError)
 
-	err := updateDisposableDomains(disposableDataURL)
-	assert.Error(t, err, "get disposable domains from https://raw.githubusercontent.com/disposable/disposable-email-domains/master/domains.json with status_code: 500")
+	event := updateDisposable(NewVerifier(), disposableDataURL)
+	assert.EqualError(t, event.Err, "get disposable list from https://raw.githubusercontent.com/disposable/disposable-email-domains/master/domains.json with status_code: 500")
 }
 
 func TestUpdateDisposableDomains_NoResponse(t *testing.T) {
@@ -61,8 +91,11 @@ func TestUpdateDisposableDomains_NoResponse(t *testing.T) {
 		Get("/disposable/disposable-email-domains/master/domains.json").
 		Reply(http.StatusOK)
 
-	err := updateDisposableDomains(disposableDataURL)
-	assert.NoError(t, err)
+	v := NewVerifier()
+	event := updateDisposable(v, disposableDataURL)
+	assert.ErrorContains(t, event.Err, "refuse to update disposable list")
+	assert.False(t, event.Modified)
+	assert.True(t, v.IsDisposable("dbbd8.club"))
 }
 
 func TestUpdateDisposableDomains_WrongResponse(t *testing.T) {
@@ -72,6 +105,133 @@ func TestUpdateDisposableDomains_WrongResponse(t *testing.T) {
 		Reply(http.StatusOK).
 		JSON("testing")
 
-	err := updateDisposableDomains(disposableDataURL)
-	assert.Error(t, err, "invalid character 'e' in literal true (expecting 'r')")
+	event := updateDisposable(NewVerifier(), disposableDataURL)
+	assert.ErrorContains(t, event.Err, "refuse to update disposable list")
+}
+
+func TestUpdateDisposableDomains_Shrunk(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://raw.githubusercontent.com").
+		Get("/disposable/disposable-email-domains/master/domains.json").
+		Reply(http.StatusOK).
+		JSON([]string{"a.org"})
+
+	v := NewVerifier()
+	event := updateDisposable(v, disposableDataURL)
+	assert.ErrorContains(t, event.Err, "refuse to update disposable list")
+	assert.Equal(t, 1, event.Entries)
+	assert.True(t, v.IsDisposable("dbbd8.club"))
+	assert.False(t, v.IsDisposable("a.org"))
+}
+
+func TestUpdateList_NotModified(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://lists.example.com").
+		Get("/free.txt").
+		Reply(http.StatusOK).
+		SetHeader("ETag", `"v1"`).
+		BodyString("free-a.example\nfree-b.example\n")
+	gock.New("https://lists.example.com").
+		Get("/free.txt").
+		MatchHeader("If-None-Match", `"v1"`).
+		Reply(http.StatusNotModified)
+
+	v := NewVerifier().ReplaceFreeDomains([]string{"free-a.example"})
+	u := newUpdater(v, AutoUpdateConfig{Sources: map[string]string{ListFree: "https://lists.example.com/free.txt"}})
+
+	event := u.update(context.Background(), u.sources[0])
+	assert.NoError(t, event.Err)
+	assert.True(t, event.Modified)
+	assert.True(t, v.IsFreeDomain("free-b.example"))
+
+	event = u.update(context.Background(), u.sources[0])
+	assert.NoError(t, event.Err)
+	assert.False(t, event.Modified)
+	assert.True(t, gock.IsDone())
+}
+
+func TestUpdateList_RefusedListIsFetchedAgain(t *testing.T) {
+	var conditional []bool
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		isConditional := req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != ""
+		conditional = append(conditional, isConditional)
+		if isConditional {
+			return &http.Response{StatusCode: http.StatusNotModified, Body: http.NoBody, Header: http.Header{}}, nil
+		}
+		header := http.Header{}
+		header.Set("ETag", `"v1"`)
+		header.Set("Last-Modified", "Sun, 18 Oct 2026 07:07:01 GMT")
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("a.org\n")), Header: header}, nil
+	})}
+
+	v := NewVerifier().ListClient(client)
+	u := newUpdater(v, AutoUpdateConfig{Sources: map[string]string{ListDisposable: "https://lists.example.com/disposable.txt"}})
+
+	// the shrunk list is refused, the validators of its response are not kept
+	for i := 0; i < 2; i++ {
+		event := u.update(context.Background(), u.sources[0])
+		assert.ErrorContains(t, event.Err, "refuse to update disposable list")
+		assert.False(t, event.Modified)
+	}
+	assert.Equal(t, []bool{false, false}, conditional)
+	assert.True(t, v.IsDisposable("dbbd8.club"))
+}
+
+func TestUpdateList_TLD(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://data.iana.org").
+		Get("/TLD/tlds-alpha-by-domain.txt").
+		Reply(http.StatusOK).
+		BodyString("# Version 2026101800, Last Updated Sun Oct 18 07:07:01 2026 UTC\nCOM\nNEWTLD\nXN--P1AI\n")
+
+	var events []UpdateEvent
+	v := NewVerifier()
+	v.topLevelDomains.replace([]string{"com"}, SourceCustom)
+	u := newUpdater(v, AutoUpdateConfig{
+		Sources:  map[string]string{ListTLD: tldDataURL},
+		OnUpdate: func(e UpdateEvent) { events = append(events, e) },
+	})
+	assert.NoError(t, u.run(context.Background()))
+
+	assert.Equal(t, []UpdateEvent{{List: ListTLD, Source: tldDataURL, Modified: true, Entries: 3}}, events)
+	assert.True(t, v.topLevelDomainExists("example.newtld"))
+	assert.True(t, v.topLevelDomainExists("example.xn--p1ai"))
+}
+
+func TestUpdateList_UnknownList(t *testing.T) {
+	v := NewVerifier()
+	u := newUpdater(v, AutoUpdateConfig{Sources: map[string]string{"unknown": "https://lists.example.com"}})
+
+	event := u.update(context.Background(), u.sources[0])
+	assert.EqualError(t, event.Err, "unknown list: unknown")
+}
+
+func TestEnableAutoUpdateContext_FirstUpdateFailed(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://lists.example.com").
+		Get("/disposable.json").
+		Reply(http.StatusNotFound)
+
+	var events []UpdateEvent
+	ctx, cancel := context.WithCancel(context.Background())
+	v := NewVerifier().EnableAutoUpdateContext(ctx, AutoUpdateConfig{
+		Sources:  map[string]string{ListDisposable: "https://lists.example.com/disposable.json"},
+		OnUpdate: func(e UpdateEvent) { events = append(events, e) },
+	})
+	defer v.DisableAutoUpdate()
+
+	status := v.AutoUpdateStatus()
+	assert.True(t, status.Running)
+	assert.EqualError(t, status.LastError, "get disposable list from https://lists.example.com/disposable.json with status_code: 404")
+	assert.Equal(t, 1, status.Failures)
+	require.Len(t, events, 1)
+	assert.ErrorIs(t, status.LastError, events[0].Err)
+
+	// the schedule stops with its context
+	v.refresher.mu.Lock()
+	done := v.refresher.done
+	v.refresher.mu.Unlock()
+	cancel()
+	<-done
+	assert.False(t, v.AutoUpdateStatus().Running)
 }
@@ -0,0 +1,187 @@
+package emailverifier
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// list is a set of metadata entries, such as disposable domains, owned by a Verifier.
+// The embedded defaults are shared by all verifiers and never modified,
+// changes made through a verifier are recorded in its own overlay (copy-on-write).
+// It is safe for concurrent use.
+type list struct {
+	name      string
+	mu        sync.RWMutex
+	base      entrySet              // embedded defaults or the entries set by replace or update, read-only
+	origin    listOrigin            // where the entries of base come from
+	added     map[string]listOrigin // entries added on top of base
+	removed   map[string]bool       // entries of base which have been removed
+	revision  uint64                // number of changes made to the list, see changes
+	normalize func(string) string
+}
+
+// newList creates a list sharing base as its entries
+func newList(name string, base entrySet, normalize func(string) string) *list {
+	return &list{
+		name:      name,
+		base:      base,
+		origin:    embeddedOrigin(name, base),
+		added:     map[string]listOrigin{},
+		removed:   map[string]bool{},
+		normalize: normalize,
+	}
+}
+
+// newDomainList creates a list of domains, entries are stored in their lower case ASCII form
+func newDomainList(name string, base entrySet) *list {
+	return newList(name, base, func(s string) string {
+		return domainToASCII(strings.ToLower(strings.TrimSpace(s)))
+	})
+}
+
+// newRoleList creates a list of usernames, entries are stored in lower case
+func newRoleList(name string, base entrySet) *list {
+	return newList(name, base, trimLower)
+}
+
+// contains reports whether entry is in the list, entry must already be normalized
+func (l *list) contains(entry string) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if _, ok := l.added[entry]; ok {
+		return true
+	}
+	return l.base.has(entry) && !l.removed[entry]
+}
+
+// lookup is like contains, but also reports where the entry comes from
+func (l *list) lookup(entry string) (ListProvenance, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if origin, ok := l.added[entry]; ok {
+		return origin.provenance(l.name, entry), true
+	}
+	if l.base.has(entry) && !l.removed[entry] {
+		return l.origin.provenance(l.name, entry), true
+	}
+	return ListProvenance{}, false
+}
+
+// matchDomain is like lookup for domains, subdomains are matched as well, see matchDomain
+func (l *list) matchDomain(domain string) (prov ListProvenance, ok bool) {
+	matchDomain(domain, func(d string) bool {
+		prov, ok = l.lookup(d)
+		return ok
+	})
+	return prov, ok
+}
+
+// add adds entries coming from source to the list,
+// entries the list started with are recorded as well so that they survive an update dropping them
+func (l *list) add(entries []string, source string) {
+	origin := listOrigin{source: source, updatedAt: time.Now()}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.revision++
+	for _, e := range entries {
+		e = l.normalize(e)
+		if e == "" {
+			continue
+		}
+		delete(l.removed, e)
+		l.added[e] = origin
+	}
+}
+
+// remove removes entries from the list
+func (l *list) remove(entries []string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.revision++
+	for _, e := range entries {
+		e = l.normalize(e)
+		delete(l.added, e)
+		if l.base.has(e) {
+			l.removed[e] = true
+		}
+	}
+}
+
+// replace replaces all entries of the list with the ones coming from source,
+// including the ones added or removed before
+func (l *list) replace(entries []string, source string) {
+	base := l.build(entries)
+	origin := listOrigin{version: listVersion(base), source: source, updatedAt: time.Now()}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.revision++
+	l.base = base
+	l.origin = origin
+	l.added = map[string]listOrigin{}
+	l.removed = map[string]bool{}
+}
+
+// update replaces the entries the list started with, such as the embedded defaults,
+// with the ones fetched from source at updatedAt.
+// Entries added or removed before are kept on top of the new entries.
+func (l *list) update(entries []string, source string, updatedAt time.Time) {
+	base := l.build(entries)
+	origin := listOrigin{version: listVersion(base), source: source, updatedAt: updatedAt}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.revision++
+	l.base = base
+	l.origin = origin
+}
+
+// changes returns the number of changes made to the list, the list still has the entries it started with when 0
+func (l *list) changes() uint64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.revision
+}
+
+// entries returns all entries of the list, in no particular order
+func (l *list) entries() []string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	entries := make([]string, 0, l.base.len()+len(l.added))
+	l.base.each(func(e string) {
+		if _, ok := l.added[e]; !ok && !l.removed[e] {
+			entries = append(entries, e)
+		}
+	})
+	for e := range l.added {
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// size returns the number of entries the list started with, or got from the last replace or update
+func (l *list) size() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.base.len()
+}
+
+// provenance returns where the entries the list started with, or got from the last replace or update, come from
+func (l *list) provenance() ListProvenance {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.origin.provenance(l.name, "")
+}
+
+// build creates a read-only set of normalized entries
+func (l *list) build(entries []string) entrySet {
+	normalized := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e = l.normalize(e); e != "" {
+			normalized = append(normalized, e)
+		}
+	}
+	return buildCompactSet(normalized)
+}
@@ -0,0 +1,117 @@
+package emailverifier
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_AddRemove(t *testing.T) {
+	l := newDomainList("test", mapSet{"a.com": true, "b.com": true})
+
+	l.add([]string{"C.com", " d.com "}, SourceCustom)
+	l.remove([]string{"a.com", "d.com"})
+
+	assert.False(t, l.contains("a.com"))
+	assert.True(t, l.contains("b.com"))
+	assert.True(t, l.contains("c.com"))
+	assert.False(t, l.contains("d.com"))
+
+	l.add([]string{"a.com"}, SourceCustom)
+	assert.True(t, l.contains("a.com"))
+}
+
+func TestList_Replace(t *testing.T) {
+	l := newDomainList("test", mapSet{"a.com": true})
+	l.add([]string{"b.com"}, SourceCustom)
+
+	l.replace([]string{"c.com"}, SourceCustom)
+	assert.False(t, l.contains("a.com"))
+	assert.False(t, l.contains("b.com"))
+	assert.True(t, l.contains("c.com"))
+}
+
+func TestList_UpdateKeepsChanges(t *testing.T) {
+	l := newDomainList("test", mapSet{"a.com": true, "b.com": true})
+	l.add([]string{"c.com"}, SourceCustom)
+	l.remove([]string{"b.com"})
+
+	l.update([]string{"b.com", "d.com"}, "https://example.com/list.txt", time.Now())
+	assert.False(t, l.contains("a.com"))
+	assert.False(t, l.contains("b.com"))
+	assert.True(t, l.contains("c.com"))
+	assert.True(t, l.contains("d.com"))
+}
+
+func TestList_UpdateKeepsAddedBaseEntries(t *testing.T) {
+	l := newDomainList("test", mapSet{"gmail.com": true})
+	l.add([]string{"gmail.com"}, SourceCustom)
+
+	l.update([]string{"a.com"}, "https://example.com/list.txt", time.Now())
+	assert.True(t, l.contains("gmail.com"))
+	assert.True(t, l.contains("a.com"))
+
+	prov, ok := l.lookup("gmail.com")
+	assert.True(t, ok)
+	assert.Equal(t, SourceCustom, prov.Source)
+}
+
+func TestList_DoesNotModifyBase(t *testing.T) {
+	base := mapSet{"a.com": true}
+	l := newDomainList("test", base)
+	l.add([]string{"b.com"}, SourceCustom)
+	l.remove([]string{"a.com"})
+
+	assert.Equal(t, mapSet{"a.com": true}, base)
+}
+
+func TestList_Concurrency(t *testing.T) {
+	l := newDomainList("test", mapSet{})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			l.add([]string{"a.com"}, SourceCustom)
+			l.remove([]string{"a.com"})
+		}()
+		go func() {
+			defer wg.Done()
+			l.contains("a.com")
+		}()
+	}
+	wg.Wait()
+}
+
+func TestVerifierLists_Isolated(t *testing.T) {
+	tenantA := NewVerifier().
+		AddDisposableDomains([]string{"tenant-a.example"}).
+		RemoveFreeDomains([]string{"gmail.com"}).
+		AddRoleAccounts([]string{"billing-team"})
+	tenantB := NewVerifier()
+
+	assert.True(t, tenantA.IsDisposable("tenant-a.example"))
+	assert.False(t, tenantB.IsDisposable("tenant-a.example"))
+
+	assert.False(t, tenantA.IsFreeDomain("gmail.com"))
+	assert.True(t, tenantB.IsFreeDomain("gmail.com"))
+
+	assert.True(t, tenantA.IsRoleAccount("Billing-Team"))
+	assert.False(t, tenantB.IsRoleAccount("billing-team"))
+}
+
+func TestVerifierLists_Replace(t *testing.T) {
+	v := NewVerifier().
+		ReplaceDisposableDomains([]string{"only-disposable.example"}).
+		ReplaceFreeDomains([]string{"only-free.example"}).
+		ReplaceRoleAccounts([]string{"only-role"})
+
+	assert.True(t, v.IsDisposable("only-disposable.example"))
+	assert.False(t, v.IsDisposable("dbbd8.club"))
+	assert.True(t, v.IsFreeDomain("only-free.example"))
+	assert.False(t, v.IsFreeDomain("gmail.com"))
+	assert.True(t, v.IsRoleAccount("only-role"))
+	assert.False(t, v.IsRoleAccount("admin"))
+}
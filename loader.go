@@ -0,0 +1,237 @@
+package emailverifier
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Names of the metadata lists owned by a Verifier
+const (
+	ListDisposable = "disposable" // disposable domains
+	ListFree       = "free"       // free email provider domains
+	ListRole       = "role"       // role-based usernames
+	ListTLD        = "tld"        // top level domains
+)
+
+// maxListSize is the maximum size in bytes of a list loaded from a URL
+const maxListSize = 64 << 20
+
+// LoadMode decides how loaded entries are combined with the entries of a list
+type LoadMode int
+
+const (
+	LoadMerge   LoadMode = iota // loaded entries are added to the list
+	LoadReplace                 // loaded entries replace all entries of the list, including the embedded ones
+)
+
+// LineError is an invalid entry found when loading a list
+type LineError struct {
+	Line   int    `json:"line"`   // line number, or position in a JSON array, starting at 1
+	Value  string `json:"value"`  // the invalid entry
+	Reason string `json:"reason"` // why the entry is invalid
+}
+
+func (e LineError) Error() string {
+	return fmt.Sprintf("line %d: %q %s", e.Line, e.Value, e.Reason)
+}
+
+// ListError is returned when a list contains invalid entries, in which case nothing is loaded
+type ListError struct {
+	List   string      `json:"list"`
+	Errors []LineError `json:"errors"`
+}
+
+func (e *ListError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, le := range e.Errors {
+		msgs[i] = le.Error()
+	}
+	return fmt.Sprintf("invalid entries in %s list: %s", e.List, strings.Join(msgs, "; "))
+}
+
+// LoadList loads entries of the named list (ListDisposable, ListFree, ListRole or ListTLD) from r.
+// The content is either a JSON array of strings, or plain text with one entry per line
+// where empty lines and lines starting with "#" are ignored.
+// The loaded entries are reported with the SourceCustom source, see EnableProvenance.
+func (v *Verifier) LoadList(name string, r io.Reader, mode LoadMode) error {
+	return v.loadList(name, r, mode, SourceCustom)
+}
+
+// loadList loads entries of the named list from r, recording source as their origin
+func (v *Verifier) loadList(name string, r io.Reader, mode LoadMode, source string) error {
+	l, validate, err := v.lookupList(name)
+	if err != nil {
+		return err
+	}
+
+	entries, lineErrs, err := parseList(r, validate)
+	if err != nil {
+		return fmt.Errorf("read %s list: %w", name, err)
+	}
+	if len(lineErrs) > 0 {
+		return &ListError{List: name, Errors: lineErrs}
+	}
+
+	switch mode {
+	case LoadMerge:
+		l.add(entries, source)
+	case LoadReplace:
+		l.replace(entries, source)
+	default:
+		return fmt.Errorf("unsupported load mode: %d", mode)
+	}
+	return nil
+}
+
+// LoadListFile loads entries of the named list from a local file, see LoadList for the supported formats.
+func (v *Verifier) LoadListFile(name, path string, mode LoadMode) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return v.loadList(name, f, mode, path)
+}
+
+// ListClient sets the HTTP client used by LoadListURL and EnableAutoUpdate, http.DefaultClient when nil
+func (v *Verifier) ListClient(client *http.Client) *Verifier {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	v.listClient = client
+	return v
+}
+
+// LoadListURL loads entries of the named list from an HTTP URL, see LoadList for the supported formats.
+// Lists larger than 64MB are refused.
+func (v *Verifier) LoadListURL(ctx context.Context, name, url string, mode LoadMode) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := v.listClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s list from %s with status_code: %d", name, url, resp.StatusCode)
+	}
+
+	content, err := readLimited(resp.Body, maxListSize)
+	if err != nil {
+		return fmt.Errorf("read %s list from %s: %w", name, url, err)
+	}
+	return v.loadList(name, bytes.NewReader(content), mode, url)
+}
+
+// readLimited reads r until EOF, failing rather than truncating the content when it exceeds limit bytes
+func readLimited(r io.Reader, limit int64) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > limit {
+		return nil, fmt.Errorf("content exceeds %d bytes", limit)
+	}
+	return content, nil
+}
+
+// lookupList returns the named list of the verifier and the validation of its entries
+func (v *Verifier) lookupList(name string) (*list, func(string) error, error) {
+	switch name {
+	case ListDisposable:
+		return v.disposableDomains, validateDomainEntry, nil
+	case ListFree:
+		return v.freeDomains, validateDomainEntry, nil
+	case ListRole:
+		return v.roleAccounts, validateRoleEntry, nil
+	case ListTLD:
+		return v.topLevelDomains, validateTLDEntry, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown list: %s", name)
+	}
+}
+
+// parseList reads the entries of a list in JSON array or plain text format,
+// entries which do not pass validate are reported as LineErrors.
+func parseList(r io.Reader, validate func(string) error) ([]string, []LineError, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var values []string
+	if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err = json.Unmarshal(trimmed, &values); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		scanner := bufio.NewScanner(bytes.NewReader(content))
+		for scanner.Scan() {
+			values = append(values, scanner.Text())
+		}
+		if err = scanner.Err(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var (
+		entries  = make([]string, 0, len(values))
+		lineErrs []LineError
+	)
+	for i, value := range values {
+		entry := strings.TrimSpace(value)
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		if err := validate(entry); err != nil {
+			lineErrs = append(lineErrs, LineError{Line: i + 1, Value: value, Reason: err.Error()})
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, lineErrs, nil
+}
+
+// validateDomainEntry checks that entry is a domain, or a wildcard entry such as "*.example.com"
+func validateDomainEntry(entry string) error {
+	domain := strings.TrimPrefix(entry, "*.")
+	if !strings.Contains(domain, ".") {
+		return errors.New("is not a domain")
+	}
+	if _, err := toASCII(domain); err != nil {
+		return fmt.Errorf("is not a valid domain: %v", err)
+	}
+	return nil
+}
+
+// validateTLDEntry checks that entry is a single domain label
+func validateTLDEntry(entry string) error {
+	if strings.Contains(entry, ".") {
+		return errors.New("is not a top level domain")
+	}
+	if _, err := toASCII(entry); err != nil {
+		return fmt.Errorf("is not a valid top level domain: %v", err)
+	}
+	return nil
+}
+
+// validateRoleEntry checks that entry can be the local part of an email address
+func validateRoleEntry(entry string) error {
+	if strings.ContainsAny(entry, "@ \t") {
+		return errors.New("is not a username")
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package emailverifier
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestLoadList_PlainTextMerge(t *testing.T) {
+	v := NewVerifier()
+	content := "# tenant specific throwaway domains\nthrowaway-a.example\n\n  Throwaway-B.example  \n*.throwaway-c.example\n"
+
+	err := v.LoadList(ListDisposable, strings.NewReader(content), LoadMerge)
+	require.NoError(t, err)
+	assert.True(t, v.IsDisposable("throwaway-a.example"))
+	assert.True(t, v.IsDisposable("throwaway-b.example"))
+	assert.True(t, v.IsDisposable("x.throwaway-c.example"))
+	assert.True(t, v.IsDisposable("dbbd8.club"))
+}
+
+func TestLoadList_JSONReplace(t *testing.T) {
+	v := NewVerifier()
+
+	err := v.LoadList(ListRole, strings.NewReader(`["billing", "Support-Team"]`), LoadReplace)
+	require.NoError(t, err)
+	assert.True(t, v.IsRoleAccount("billing"))
+	assert.True(t, v.IsRoleAccount("support-team"))
+	assert.False(t, v.IsRoleAccount("admin"))
+}
+
+func TestLoadList_InvalidLines(t *testing.T) {
+	v := NewVerifier()
+	content := "valid.example\nnot a domain\nlocalhost\nex_ample.com\n"
+
+	err := v.LoadList(ListFree, strings.NewReader(content), LoadMerge)
+	var listErr *ListError
+	require.True(t, errors.As(err, &listErr))
+	assert.Equal(t, ListFree, listErr.List)
+	assert.Equal(t, []int{2, 3, 4}, []int{listErr.Errors[0].Line, listErr.Errors[1].Line, listErr.Errors[2].Line})
+	assert.Equal(t, "localhost", listErr.Errors[1].Value)
+	// nothing is loaded when the list has invalid entries
+	assert.False(t, v.IsFreeDomain("valid.example"))
+}
+
+func TestLoadList_InvalidJSON(t *testing.T) {
+	err := NewVerifier().LoadList(ListFree, strings.NewReader(`["a.example",`), LoadMerge)
+	assert.Error(t, err)
+}
+
+func TestLoadList_UnknownList(t *testing.T) {
+	err := NewVerifier().LoadList("unknown", strings.NewReader(""), LoadMerge)
+	assert.EqualError(t, err, "unknown list: unknown")
+}
+
+func TestLoadListFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "free.txt")
+	require.NoError(t, os.WriteFile(path, []byte("tenant-mail.example\n"), 0o600))
+
+	v := NewVerifier()
+	require.NoError(t, v.LoadListFile(ListFree, path, LoadMerge))
+	assert.True(t, v.IsFreeDomain("tenant-mail.example"))
+
+	assert.Error(t, v.LoadListFile(ListFree, filepath.Join(t.TempDir(), "missing.txt"), LoadMerge))
+}
+
+func TestLoadListURL(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://lists.example.com").
+		Get("/disposable.json").
+		Reply(http.StatusOK).
+		JSON([]string{"remote-throwaway.example"})
+
+	v := NewVerifier()
+	err := v.LoadListURL(context.Background(), ListDisposable, "https://lists.example.com/disposable.json", LoadReplace)
+	require.NoError(t, err)
+	assert.True(t, v.IsDisposable("remote-throwaway.example"))
+	assert.False(t, v.IsDisposable("dbbd8.club"))
+}
+
+func TestLoadListURL_StatusNotFound(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://lists.example.com").
+		Get("/disposable.json").
+		Reply(http.StatusNotFound)
+
+	err := NewVerifier().LoadListURL(context.Background(), ListDisposable, "https://lists.example.com/disposable.json", LoadMerge)
+	assert.EqualError(t, err, "get disposable list from https://lists.example.com/disposable.json with status_code: 404")
+}
+
+func TestLoadListURL_Client(t *testing.T) {
+	var requested string
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL.String()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("client-throwaway.example\n")),
+			Header:     http.Header{},
+		}, nil
+	})}
+
+	v := NewVerifier().ListClient(client)
+	err := v.LoadListURL(context.Background(), ListDisposable, "https://lists.example.com/disposable.txt", LoadMerge)
+	require.NoError(t, err)
+	assert.Equal(t, "https://lists.example.com/disposable.txt", requested)
+	assert.True(t, v.IsDisposable("client-throwaway.example"))
+
+	assert.Same(t, http.DefaultClient, v.ListClient(nil).listClient)
+}
+
+func TestReadLimited(t *testing.T) {
+	content, err := readLimited(strings.NewReader("a.example\n"), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, "a.example\n", string(content))
+
+	_, err = readLimited(strings.NewReader("a.example\nb.example\n"), 10)
+	assert.EqualError(t, err, "content exceeds 10 bytes")
+}
+
+// roundTripFunc is an http.RoundTripper answering requests without network
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -162,6 +162,8 @@ var roleAccounts = map[string]bool{
 	"centro":                 true,
 	"ceo":                    true,
 	"ceos":                   true,
+	"cfo":                    true,
+	"cfos":                   true,
 	"channel-sales":          true,
 	"chat":                   true,
 	"chatter":                true,
@@ -251,6 +253,8 @@ var roleAccounts = map[string]bool{
 	"cs":                     true,
 	"csm":                    true,
 	"csteam":                 true,
+	"cto":                    true,
+	"ctos":                   true,
 	"cultura":                true,
 	"culture":                true,
 	"customer":               true,
@@ -53,3 +53,32 @@ var suggestionTopLevelDomains = map[string]bool{
 	"hu":     true,
 	"uk":     true,
 }
+
+// popularDomains are the most used email domains with their popularity from 0 to 1,
+// the keyboard aware typo model prefers them over similar but rarely used domains
+var popularDomains = map[string]float32{
+	"gmail.com":      1,
+	"yahoo.com":      0.9,
+	"hotmail.com":    0.85,
+	"outlook.com":    0.8,
+	"icloud.com":     0.7,
+	"qq.com":         0.6,
+	"aol.com":        0.6,
+	"live.com":       0.6,
+	"163.com":        0.5,
+	"mail.ru":        0.5,
+	"yandex.ru":      0.5,
+	"gmx.de":         0.5,
+	"web.de":         0.5,
+	"msn.com":        0.5,
+	"hotmail.co.uk":  0.4,
+	"yahoo.co.uk":    0.4,
+	"googlemail.com": 0.4,
+	"protonmail.com": 0.4,
+	"me.com":         0.4,
+	"comcast.net":    0.4,
+	"gmx.com":        0.3,
+	"ymail.com":      0.3,
+	"free.fr":        0.3,
+	"orange.fr":      0.3,
+}
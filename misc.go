@@ -2,24 +2,57 @@ package emailverifier
 
 import (
 	"strings"
-	"sync"
 )
 
-var disposableSyncDomains sync.Map // concurrent safe map to store disposable domains data
-
 // IsRoleAccount checks if username is a role-based account
 func (v *Verifier) IsRoleAccount(username string) bool {
-	return roleAccounts[strings.ToLower(username)]
+	return v.roleAccounts.contains(strings.ToLower(username))
 }
 
-// IsFreeDomain checks if domain is a free domain
+// IsFreeDomain checks if domain is a free domain,
+// subdomains of free domains are matched as well, see matchDomain
 func (v *Verifier) IsFreeDomain(domain string) bool {
-	return freeDomains[domain]
+	if v.freeDomains.contains(domain) {
+		return true
+	}
+	return matchDomain(domainToASCII(domain), v.freeDomains.contains)
 }
 
-// IsDisposable checks if domain is a disposable domain
+// IsDisposable checks if domain is a disposable domain,
+// subdomains of disposable domains are matched as well, see matchDomain
 func (v *Verifier) IsDisposable(domain string) bool {
-	domain = domainToASCII(domain)
-	_, found := disposableSyncDomains.Load(domain)
-	return found
+	return matchDomain(domainToASCII(domain), v.disposableDomains.contains)
+}
+
+// matchDomain reports whether the ASCII domain is matched by a list, using contains to look up entries.
+// A domain is matched when
+//   - the domain itself is in the list
+//   - one of its parent domains up to the registrable domain is in the list,
+//     e.g. "x7.mailinator.com" is matched by "mailinator.com"
+//   - a wildcard entry for one of its parent domains is in the list,
+//     e.g. "x7.mailinator.com" is matched by "*.mailinator.com" or "*.com".
+//     Wildcards match subdomains only and may cover parent domains beyond the registrable domain.
+func matchDomain(domain string, contains func(string) bool) bool {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if domain == "" {
+		return false
+	}
+	if contains(domain) {
+		return true
+	}
+
+	registrable := registrableDomain(domain)
+	for {
+		i := strings.IndexByte(domain, '.')
+		if i == -1 {
+			return false
+		}
+		domain = domain[i+1:]
+		if contains("*." + domain) {
+			return true
+		}
+		if registrable != "" && len(domain) >= len(registrable) && contains(domain) {
+			return true
+		}
+	}
 }
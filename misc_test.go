@@ -49,3 +49,52 @@ func TestIsRoleAccount_False(t *testing.T) {
 	isRoleAccount := verifier.IsRoleAccount(username)
 	assert.False(t, isRoleAccount)
 }
+
+func TestIsDisposableDomain_Subdomain(t *testing.T) {
+	assert.True(t, verifier.IsDisposable("x7.dbbd8.club"))
+	assert.True(t, verifier.IsDisposable("a.b.dbbd8.club"))
+	assert.False(t, verifier.IsDisposable("dbbd8.club.example.com"))
+}
+
+func TestIsFreeDomain_Subdomain(t *testing.T) {
+	assert.True(t, verifier.IsFreeDomain("mail.gmail.com"))
+	assert.False(t, verifier.IsFreeDomain("gmail.com.example.org"))
+}
+
+func TestAddDisposableDomains_Wildcard(t *testing.T) {
+	verifier := NewVerifier().AddDisposableDomains([]string{"*.Throwaway-Wildcard.example"})
+
+	assert.True(t, verifier.IsDisposable("random.throwaway-wildcard.example"))
+	assert.True(t, verifier.IsDisposable("a.b.throwaway-wildcard.example"))
+	assert.False(t, verifier.IsDisposable("throwaway-wildcard.example"))
+}
+
+func TestMatchDomain(t *testing.T) {
+	list := map[string]bool{
+		"example.co.uk":   true,
+		"*.dyn.example":   true,
+		"co.uk":           true,
+		"*.wildcard.test": true,
+	}
+	contains := func(d string) bool { return list[d] }
+
+	tests := []struct {
+		domain string
+		want   bool
+	}{
+		{domain: "example.co.uk", want: true},
+		{domain: "mail.example.co.uk", want: true},
+		{domain: "Mail.Example.CO.UK.", want: true},
+		{domain: "other.co.uk", want: false}, // "co.uk" is a public suffix, parent matching stops at the registrable domain
+		{domain: "co.uk", want: true},
+		{domain: "a.dyn.example", want: true},
+		{domain: "dyn.example", want: false},
+		{domain: "a.b.wildcard.test", want: true},
+		{domain: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchDomain(tt.domain, contains))
+		})
+	}
+}
@@ -12,11 +12,28 @@ type Mx struct {
 	Records     []*net.MX // represent DNS MX records
 }
 
+// Resolver looks up the MX records of domains, *net.Resolver is a Resolver
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// UseResolver sets the resolver used to look up MX records, net.DefaultResolver when nil
+func (v *Verifier) UseResolver(resolver Resolver) *Verifier {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	v.resolver = resolver
+	return v
+}
+
 // CheckMX will return the DNS MX records for the given domain name sorted by preference.
 func (v *Verifier) CheckMX(ctx context.Context, domain string) (*Mx, error) {
-	domain = domainToASCII(domain)
+	domain, err := toASCII(domain)
+	if err != nil {
+		return nil, fmt.Errorf("invalid domain: %w", err)
+	}
 
-	if !TopLevelDomainExists(domain) {
+	if !v.topLevelDomainExists(domain) {
 		return nil, fmt.Errorf("TLD domain %q does not exist", domain)
 	}
 
@@ -24,7 +41,7 @@ func (v *Verifier) CheckMX(ctx context.Context, domain string) (*Mx, error) {
 		return &Mx{}, nil
 	}
 
-	mx, err := net.DefaultResolver.LookupMX(ctx, domain)
+	mx, err := v.resolver.LookupMX(ctx, domain)
 	if err != nil && len(mx) == 0 {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package emailverifier
 
 import (
 	"context"
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -22,3 +23,34 @@ func TestCheckNoMxOK(t *testing.T) {
 	assert.Nil(t, mx)
 	assert.Error(t, err, ErrNoSuchHost)
 }
+
+// stubResolver resolves the MX records of the domains it maps, other domains do not exist
+type stubResolver map[string][]*net.MX
+
+func (r stubResolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	if mx, ok := r[name]; ok {
+		return mx, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func TestCheckMX_Resolver(t *testing.T) {
+	v := NewVerifier().UseResolver(stubResolver{"example.org": {{Host: "mx.example.org.", Pref: 10}}})
+
+	mx, err := v.CheckMX(context.Background(), "example.org")
+	assert.NoError(t, err)
+	assert.True(t, mx.HasMXRecord)
+	assert.Equal(t, "mx.example.org.", mx.Records[0].Host)
+
+	_, err = v.CheckMX(context.Background(), "example.net")
+	assert.Error(t, err)
+
+	assert.Same(t, net.DefaultResolver, v.UseResolver(nil).resolver)
+}
+
+func TestCheckMX_InvalidDomain(t *testing.T) {
+	v := NewVerifier().UseResolver(stubResolver{"ex_ample.org": {{Host: "mx.example.org.", Pref: 10}}})
+
+	_, err := v.CheckMX(context.Background(), "ex_ample.org")
+	assert.ErrorContains(t, err, "invalid domain")
+}
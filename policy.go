@@ -0,0 +1,227 @@
+package emailverifier
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Policy actions
+const (
+	PolicyAllow = "allow" // the address is accepted without network checks
+	PolicyBlock = "block" // the address is rejected without network checks
+)
+
+// PolicyMatch stores the policy rule which decided the verification result
+type PolicyMatch struct {
+	Action string `json:"action"` // PolicyAllow or PolicyBlock
+	Rule   string `json:"rule"`   // the matched rule, e.g. "domain:example.com" or "local_part:noreply*"
+}
+
+// policy is a set of allow and block rules owned by a Verifier.
+// Rules for exact addresses are evaluated first, then block rules and finally allow rules,
+// so that block rules win over allow rules unless the address itself is allowed.
+// It is safe for concurrent use.
+type policy struct {
+	mu         sync.RWMutex
+	addresses  map[string]string // address => action
+	domains    map[string]string // ASCII domain => action, subdomains are matched via matchDomain
+	localParts []localPartRule
+}
+
+// localPartRule matches the local part of addresses by a glob or regular expression pattern
+type localPartRule struct {
+	action  string
+	pattern string
+	re      *regexp.Regexp // set when pattern is a regular expression, such as "/^test[0-9]+$/"
+}
+
+func newPolicy() *policy {
+	return &policy{
+		addresses: map[string]string{},
+		domains:   map[string]string{},
+	}
+}
+
+// addAddresses adds rules for exact email addresses, their domain is stored in its ASCII form like domain rules.
+// None is added if an address is invalid.
+func (p *policy) addAddresses(action string, addresses []string) error {
+	keys := make([]string, 0, len(addresses))
+	for _, a := range addresses {
+		address := norm.NFC.String(trimLower(a))
+		i := strings.LastIndex(address, "@")
+		if i <= 0 {
+			return fmt.Errorf("invalid policy address %q: missing local part or @", a)
+		}
+		asciiDomain, err := toASCII(address[i+1:])
+		if err != nil {
+			return fmt.Errorf("invalid policy address %q: %w", a, err)
+		}
+		keys = append(keys, address[:i]+"@"+asciiDomain)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, k := range keys {
+		p.addresses[k] = action
+	}
+	return nil
+}
+
+// addDomains adds rules for domains and their subdomains, none is added if a domain is not a valid IDN
+func (p *policy) addDomains(action string, domains []string) error {
+	asciiDomains := make([]string, 0, len(domains))
+	for _, d := range domains {
+		// the wildcard of "*.example.com" is not a valid label, only the domain is converted
+		wildcard, domain := "", trimLower(d)
+		if rest, ok := strings.CutPrefix(domain, "*."); ok {
+			wildcard, domain = "*.", rest
+		}
+		asciiDomain, err := toASCII(domain)
+		if err != nil {
+			return fmt.Errorf("invalid policy domain %q: %w", d, err)
+		}
+		asciiDomains = append(asciiDomains, wildcard+asciiDomain)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, d := range asciiDomains {
+		p.domains[d] = action
+	}
+	return nil
+}
+
+// addLocalParts adds rules for local parts, patterns are glob patterns as supported by path.Match
+// or regular expressions enclosed in slashes, both matched case-insensitively
+func (p *policy) addLocalParts(action string, patterns []string) error {
+	rules := make([]localPartRule, 0, len(patterns))
+	for _, pattern := range patterns {
+		rule := localPartRule{action: action, pattern: pattern}
+		if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+			re, err := regexp.Compile("(?i)" + pattern[1:len(pattern)-1])
+			if err != nil {
+				return fmt.Errorf("invalid local part pattern %q: %w", pattern, err)
+			}
+			rule.re = re
+		} else {
+			rule.pattern = strings.ToLower(pattern)
+			if _, err := path.Match(rule.pattern, ""); err != nil {
+				return fmt.Errorf("invalid local part pattern %q: %w", pattern, err)
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.localParts = append(p.localParts, rules...)
+	return nil
+}
+
+// evaluate returns the rule matching the address, or nil when no rule matches
+func (p *policy) evaluate(username, domain string) *PolicyMatch {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	asciiDomain := domainToASCII(domain)
+	address := strings.ToLower(norm.NFC.String(username)) + "@" + asciiDomain
+	if action, ok := p.addresses[address]; ok {
+		return &PolicyMatch{Action: action, Rule: "address:" + address}
+	}
+
+	var matches []*PolicyMatch
+	for _, rule := range p.localParts {
+		if rule.match(username) {
+			matches = append(matches, &PolicyMatch{Action: rule.action, Rule: "local_part:" + rule.pattern})
+		}
+	}
+	// the most specific domain rule is found first
+	var matchedDomain string
+	matchDomain(asciiDomain, func(d string) bool {
+		if _, ok := p.domains[d]; ok {
+			matchedDomain = d
+			return true
+		}
+		return false
+	})
+	if matchedDomain != "" {
+		matches = append(matches, &PolicyMatch{Action: p.domains[matchedDomain], Rule: "domain:" + matchedDomain})
+	}
+
+	for _, m := range matches {
+		if m.Action == PolicyBlock {
+			return m
+		}
+	}
+	if len(matches) > 0 {
+		return matches[0]
+	}
+	return nil
+}
+
+// match reports whether the rule matches username
+func (r localPartRule) match(username string) bool {
+	if r.re != nil {
+		return r.re.MatchString(username)
+	}
+	matched, _ := path.Match(r.pattern, strings.ToLower(username))
+	return matched
+}
+
+// AllowAddresses makes Verify accept the addresses without network checks. Invalid addresses are reported by Err.
+func (v *Verifier) AllowAddresses(addresses []string) *Verifier {
+	if err := v.policy.addAddresses(PolicyAllow, addresses); err != nil {
+		return v.fail(err)
+	}
+	return v
+}
+
+// BlockAddresses makes Verify reject the addresses without network checks. Invalid addresses are reported by Err.
+func (v *Verifier) BlockAddresses(addresses []string) *Verifier {
+	if err := v.policy.addAddresses(PolicyBlock, addresses); err != nil {
+		return v.fail(err)
+	}
+	return v
+}
+
+// AllowDomains makes Verify accept addresses of the domains and their subdomains without network checks,
+// unless they are blocked by another rule. Invalid domains are reported by Err.
+func (v *Verifier) AllowDomains(domains []string) *Verifier {
+	if err := v.policy.addDomains(PolicyAllow, domains); err != nil {
+		return v.fail(err)
+	}
+	return v
+}
+
+// BlockDomains makes Verify reject addresses of the domains and their subdomains without network checks,
+// unless the address itself is allowed. Invalid domains are reported by Err.
+func (v *Verifier) BlockDomains(domains []string) *Verifier {
+	if err := v.policy.addDomains(PolicyBlock, domains); err != nil {
+		return v.fail(err)
+	}
+	return v
+}
+
+// AllowLocalParts makes Verify accept addresses whose local part matches one of the patterns,
+// unless they are blocked by another rule. Patterns are case-insensitive globs such as "noreply*",
+// or regular expressions enclosed in slashes such as "/^test[0-9]+$/". Invalid patterns are reported by Err.
+func (v *Verifier) AllowLocalParts(patterns []string) *Verifier {
+	if err := v.policy.addLocalParts(PolicyAllow, patterns); err != nil {
+		return v.fail(err)
+	}
+	return v
+}
+
+// BlockLocalParts makes Verify reject addresses whose local part matches one of the patterns,
+// unless the address itself is allowed. Patterns are the same as in AllowLocalParts.
+func (v *Verifier) BlockLocalParts(patterns []string) *Verifier {
+	if err := v.policy.addLocalParts(PolicyBlock, patterns); err != nil {
+		return v.fail(err)
+	}
+	return v
+}
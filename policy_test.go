@@ -0,0 +1,120 @@
+package emailverifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyEvaluate(t *testing.T) {
+	v := NewVerifier().
+		AllowDomains([]string{"aftership.com"}).
+		BlockDomains([]string{"abuse.example", "*.spam.example"}).
+		AllowAddresses([]string{"Reporter@Abuse.example"}).
+		BlockAddresses([]string{"former.employee@aftership.com"}).
+		BlockLocalParts([]string{"test*", "/^[0-9]{8,}$/"}).
+		AllowLocalParts([]string{"postmaster"})
+	require.NoError(t, v.Err())
+
+	tests := []struct {
+		username string
+		domain   string
+		want     *PolicyMatch
+	}{
+		{username: "john", domain: "aftership.com", want: &PolicyMatch{Action: PolicyAllow, Rule: "domain:aftership.com"}},
+		{username: "john", domain: "mail.aftership.com", want: &PolicyMatch{Action: PolicyAllow, Rule: "domain:aftership.com"}},
+		{username: "former.employee", domain: "aftership.com", want: &PolicyMatch{Action: PolicyBlock, Rule: "address:former.employee@aftership.com"}},
+		{username: "testuser", domain: "aftership.com", want: &PolicyMatch{Action: PolicyBlock, Rule: "local_part:test*"}},
+		{username: "12345678", domain: "gmail.com", want: &PolicyMatch{Action: PolicyBlock, Rule: "local_part:/^[0-9]{8,}$/"}},
+		{username: "john", domain: "abuse.example", want: &PolicyMatch{Action: PolicyBlock, Rule: "domain:abuse.example"}},
+		{username: "reporter", domain: "abuse.example", want: &PolicyMatch{Action: PolicyAllow, Rule: "address:reporter@abuse.example"}},
+		{username: "postmaster", domain: "abuse.example", want: &PolicyMatch{Action: PolicyBlock, Rule: "domain:abuse.example"}},
+		{username: "john", domain: "a.spam.example", want: &PolicyMatch{Action: PolicyBlock, Rule: "domain:*.spam.example"}},
+		{username: "postmaster", domain: "gmail.com", want: &PolicyMatch{Action: PolicyAllow, Rule: "local_part:postmaster"}},
+		{username: "john", domain: "gmail.com", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.username+"@"+tt.domain, func(t *testing.T) {
+			assert.Equal(t, tt.want, v.policy.evaluate(tt.username, tt.domain))
+		})
+	}
+}
+
+func TestPolicyMixedCasePattern(t *testing.T) {
+	v := NewVerifier().
+		BlockLocalParts([]string{"NoReply*", "/^Bounce-[0-9]+$/"})
+	require.NoError(t, v.Err())
+
+	for _, username := range []string{"noreply-x", "NoReply-x", "NOREPLY", "bounce-42", "BOUNCE-42"} {
+		assert.NotNil(t, v.policy.evaluate(username, "example.com"), username)
+	}
+	assert.Nil(t, v.policy.evaluate("reply", "example.com"))
+	assert.Equal(t, "local_part:noreply*", v.policy.evaluate("NoReply-x", "example.com").Rule)
+}
+
+func TestPolicyInvalidPattern(t *testing.T) {
+	assert.Error(t, NewVerifier().BlockLocalParts([]string{"/[/"}).Err())
+	assert.Error(t, NewVerifier().AllowLocalParts([]string{"[a-"}).Err())
+	assert.Error(t, NewVerifier().BlockDomains([]string{"ex_ample.com"}).Err())
+
+	v := NewVerifier().AllowDomains([]string{"example.com", "a..com"})
+	assert.ErrorContains(t, v.Err(), `invalid policy domain "a..com"`)
+	assert.Nil(t, v.policy.evaluate("john", "example.com"))
+}
+
+func TestPolicyIDNDomain(t *testing.T) {
+	v := NewVerifier().BlockDomains([]string{"*.Доменное.com"})
+	require.NoError(t, v.Err())
+	assert.Equal(t, &PolicyMatch{Action: PolicyBlock, Rule: "domain:*.xn--d1aca0agade.com"}, v.policy.evaluate("john", "mail.доменное.com"))
+}
+
+func TestCheckEmail_PolicyAllow(t *testing.T) {
+	const (
+		username = "john"
+		domain   = "corp.internal"
+		email    = username + "@" + domain
+	)
+
+	v := NewVerifier().EnableSMTPCheck().AllowDomains([]string{domain})
+	ret, err := v.Verify(context.Background(), email)
+	assert.NoError(t, err)
+	assert.Equal(t, reachableYes, ret.Reachable)
+	assert.Equal(t, &PolicyMatch{Action: PolicyAllow, Rule: "domain:corp.internal"}, ret.Policy)
+	assert.Nil(t, ret.SMTP)
+}
+
+func TestCheckEmail_PolicyBlock(t *testing.T) {
+	const (
+		username = "john"
+		domain   = "gmail.com"
+		email    = username + "@" + domain
+	)
+
+	v := NewVerifier().EnableSMTPCheck().BlockAddresses([]string{email})
+	ret, err := v.Verify(context.Background(), email)
+	assert.NoError(t, err)
+	assert.Equal(t, reachableNo, ret.Reachable)
+	assert.Equal(t, &PolicyMatch{Action: PolicyBlock, Rule: "address:john@gmail.com"}, ret.Policy)
+	assert.True(t, ret.Free)
+	assert.Nil(t, ret.SMTP)
+}
+
+func TestPolicyIDNAddress(t *testing.T) {
+	v := NewVerifier().BlockAddresses([]string{"John@Доменное.com"})
+	require.NoError(t, v.Err())
+
+	want := &PolicyMatch{Action: PolicyBlock, Rule: "address:john@xn--d1aca0agade.com"}
+	assert.Equal(t, want, v.policy.evaluate("john", "xn--d1aca0agade.com"))
+	assert.Equal(t, want, v.policy.evaluate("john", "доменное.com"))
+	assert.Nil(t, v.policy.evaluate("jane", "доменное.com"))
+
+	// the local part is compared in NFC, "e" followed by a combining acute accent is "é"
+	v = NewVerifier().AllowAddresses([]string{"rene\u0301@example.com"})
+	require.NoError(t, v.Err())
+	assert.NotNil(t, v.policy.evaluate("ren\u00e9", "example.com"))
+
+	assert.ErrorContains(t, NewVerifier().AllowAddresses([]string{"john@ex_ample.com"}).Err(), `invalid policy address "john@ex_ample.com"`)
+	assert.Error(t, NewVerifier().BlockAddresses([]string{"example.com"}).Err())
+}
@@ -0,0 +1,122 @@
+package emailverifier
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// SourceEmbedded is the source of the lists built into the package by cmd/build_metadata
+	SourceEmbedded = "embedded"
+	// SourceCustom is the source of the entries added, replaced or loaded by the caller
+	SourceCustom = "custom"
+)
+
+// ListProvenance describes where a list, or one of its entries, comes from
+type ListProvenance struct {
+	List      string    `json:"list"`              // name of the list, e.g. ListDisposable
+	Entry     string    `json:"entry,omitempty"`   // the entry which matched, e.g. "mailinator.com" for "x7.mailinator.com"
+	Version   string    `json:"version,omitempty"` // hash of the list entries, empty for entries added by the caller
+	Source    string    `json:"source"`            // SourceEmbedded, SourceCustom, or the URL or file the list was loaded from
+	UpdatedAt time.Time `json:"updated_at"`        // when the list was built, fetched or changed
+}
+
+// metadataVersion is the version of an embedded list, generated by cmd/build_metadata
+type metadataVersion struct {
+	version string // hash of the list entries, see listVersion
+	builtAt string // RFC 3339 build timestamp
+}
+
+// listOrigin records where entries of a list come from
+type listOrigin struct {
+	version   string
+	source    string
+	updatedAt time.Time
+}
+
+// provenance describes entry of the named list coming from o
+func (o listOrigin) provenance(list, entry string) ListProvenance {
+	return ListProvenance{
+		List:      list,
+		Entry:     entry,
+		Version:   o.version,
+		Source:    o.source,
+		UpdatedAt: o.updatedAt,
+	}
+}
+
+// embeddedOrigin returns the origin of the embedded entries of the named list,
+// lists which are not generated by cmd/build_metadata get their version computed from base
+func embeddedOrigin(name string, base entrySet) listOrigin {
+	mv, ok := metadataVersions[name]
+	if !ok {
+		return listOrigin{version: listVersion(base), source: SourceEmbedded}
+	}
+	builtAt, _ := time.Parse(time.RFC3339, mv.builtAt)
+	return listOrigin{version: mv.version, source: SourceEmbedded, updatedAt: builtAt}
+}
+
+// listVersion returns a short hash identifying the entries of a list regardless of their order.
+// cmd/build_metadata computes the versions of the embedded lists the same way.
+func listVersion(entries entrySet) string {
+	keys := make([]string, 0, entries.len())
+	entries.each(func(entry string) {
+		keys = append(keys, entry)
+	})
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// ListVersion returns where the entries of the named list (ListDisposable, ListFree, ListRole or ListTLD) come from:
+// the embedded data, the last automatic update, snapshot or load, or entries replaced by the caller.
+// Entries added on top of the list are not taken into account.
+func (v *Verifier) ListVersion(name string) (ListProvenance, error) {
+	l, _, err := v.lookupList(name)
+	if err != nil {
+		return ListProvenance{}, err
+	}
+	return l.provenance(), nil
+}
+
+// EnableProvenance reports in Result.Provenance which list entries
+// set the Free, Disposable and RoleAccount flags, along with the list version and source.
+func (v *Verifier) EnableProvenance() *Verifier {
+	v.provenanceEnabled = true
+	return v
+}
+
+// DisableProvenance stops reporting the provenance of list flags, the default
+func (v *Verifier) DisableProvenance() *Verifier {
+	v.provenanceEnabled = false
+	return v
+}
+
+// listProvenance returns the list entries matching the username or domain,
+// mirroring IsFreeDomain, IsDisposable and IsRoleAccount
+func (v *Verifier) listProvenance(username, domain string) []ListProvenance {
+	var provs []ListProvenance
+
+	prov, ok := v.freeDomains.lookup(domain)
+	if !ok {
+		prov, ok = v.freeDomains.matchDomain(domainToASCII(domain))
+	}
+	if ok {
+		provs = append(provs, prov)
+	}
+	if prov, ok := v.disposableDomains.matchDomain(domainToASCII(domain)); ok {
+		provs = append(provs, prov)
+	}
+	if prov, ok := v.roleAccounts.lookup(strings.ToLower(username)); ok {
+		provs = append(provs, prov)
+	}
+	return provs
+}
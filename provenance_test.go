@@ -0,0 +1,100 @@
+package emailverifier
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListVersion_IgnoresOrder(t *testing.T) {
+	a := listVersion(mapSet{"a.com": true, "b.com": true})
+	b := listVersion(mapSet{"b.com": true, "a.com": true})
+	c := listVersion(mapSet{"a.com": true})
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+	assert.Len(t, a, 16)
+}
+
+func TestListVersion_Embedded(t *testing.T) {
+	v := NewVerifier()
+
+	for _, name := range []string{ListDisposable, ListFree, ListRole} {
+		prov, err := v.ListVersion(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, prov.List)
+		assert.Equal(t, SourceEmbedded, prov.Source)
+		assert.Equal(t, metadataVersions[name].version, prov.Version)
+		assert.False(t, prov.UpdatedAt.IsZero())
+	}
+
+	prov, err := v.ListVersion(ListTLD)
+	require.NoError(t, err)
+	assert.Equal(t, SourceEmbedded, prov.Source)
+	assert.Equal(t, listVersion(mapSet(topLevelDomains)), prov.Version)
+
+	_, err = v.ListVersion("unknown")
+	assert.Error(t, err)
+}
+
+func TestListVersion_TracksChanges(t *testing.T) {
+	v := NewVerifier()
+	embedded, _ := v.ListVersion(ListDisposable)
+
+	v.AddDisposableDomains([]string{"added.example"})
+	prov, _ := v.ListVersion(ListDisposable)
+	assert.Equal(t, embedded, prov)
+
+	err := v.LoadList(ListDisposable, strings.NewReader("a.example\nb.example"), LoadReplace)
+	require.NoError(t, err)
+	prov, _ = v.ListVersion(ListDisposable)
+	assert.Equal(t, SourceCustom, prov.Source)
+	assert.Equal(t, listVersion(mapSet{"a.example": true, "b.example": true}), prov.Version)
+
+	fetchedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	v.disposableDomains.update([]string{"c.example"}, "https://example.com/disposable.txt", fetchedAt)
+	prov, _ = v.ListVersion(ListDisposable)
+	assert.Equal(t, "https://example.com/disposable.txt", prov.Source)
+	assert.Equal(t, fetchedAt, prov.UpdatedAt)
+}
+
+func TestVerify_Provenance(t *testing.T) {
+	v := NewVerifier().DisableMXCheck().EnableProvenance().
+		AddDisposableDomains([]string{"throwaway.io"})
+
+	ret, err := v.Verify(context.Background(), "admin@x7.mailinator.com")
+	require.NoError(t, err)
+	require.Len(t, ret.Provenance, 2)
+	assert.Equal(t, ListDisposable, ret.Provenance[0].List)
+	assert.Equal(t, "mailinator.com", ret.Provenance[0].Entry)
+	assert.Equal(t, SourceEmbedded, ret.Provenance[0].Source)
+	assert.Equal(t, metadataVersions[ListDisposable].version, ret.Provenance[0].Version)
+	assert.Equal(t, ListRole, ret.Provenance[1].List)
+	assert.Equal(t, "admin", ret.Provenance[1].Entry)
+
+	ret, err = v.Verify(context.Background(), "someone@throwaway.io")
+	require.NoError(t, err)
+	require.Len(t, ret.Provenance, 1)
+	assert.Equal(t, ListDisposable, ret.Provenance[0].List)
+	assert.Equal(t, SourceCustom, ret.Provenance[0].Source)
+	assert.Empty(t, ret.Provenance[0].Version)
+
+	ret, err = v.Verify(context.Background(), "someone@gmail.com")
+	require.NoError(t, err)
+	require.Len(t, ret.Provenance, 1)
+	assert.Equal(t, ListFree, ret.Provenance[0].List)
+	assert.Equal(t, "gmail.com", ret.Provenance[0].Entry)
+}
+
+func TestVerify_ProvenanceDisabled(t *testing.T) {
+	v := NewVerifier().DisableMXCheck()
+
+	ret, err := v.Verify(context.Background(), "admin@gmail.com")
+	require.NoError(t, err)
+	assert.True(t, ret.Free)
+	assert.Nil(t, ret.Provenance)
+}
@@ -0,0 +1,53 @@
+package emailverifier
+
+import "strings"
+
+// publicSuffix returns the public suffix of the ASCII domain according to the Public Suffix List,
+// e.g. "co.uk" for "mail.example.co.uk". Unlisted TLDs are public suffixes as per the "*" rule.
+func publicSuffix(domain string) string {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if domain == "" {
+		return ""
+	}
+
+	labels := strings.Split(domain, ".")
+	for i := range labels {
+		suffix := strings.Join(labels[i:], ".")
+		// exception rules take priority over the wildcard rule they belong to
+		if publicSuffixRules["!"+suffix] {
+			return strings.Join(labels[i+1:], ".")
+		}
+		if publicSuffixRules[suffix] {
+			return suffix
+		}
+		if i+1 < len(labels) && publicSuffixRules["*."+strings.Join(labels[i+1:], ".")] {
+			return suffix
+		}
+	}
+	return labels[len(labels)-1]
+}
+
+// registrableDomain returns the public suffix of the ASCII domain plus one more label,
+// e.g. "example.co.uk" for "mail.example.co.uk". It is empty when domain is a public suffix itself.
+func registrableDomain(domain string) string {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	suffix := publicSuffix(domain)
+	if suffix == "" || len(domain) <= len(suffix) {
+		return ""
+	}
+
+	rest := domain[:len(domain)-len(suffix)-1]
+	return rest[strings.LastIndex(rest, ".")+1:] + "." + suffix
+}
+
+// lastLabels returns the last n labels of domain
+func lastLabels(domain string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	labels := strings.Split(strings.TrimSuffix(domain, "."), ".")
+	if n > len(labels) {
+		n = len(labels)
+	}
+	return strings.Join(labels[len(labels)-n:], ".")
+}
@@ -0,0 +1,49 @@
+package emailverifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicSuffix(t *testing.T) {
+	tests := []struct {
+		domain            string
+		publicSuffix      string
+		registrableDomain string
+	}{
+		{domain: "", publicSuffix: "", registrableDomain: ""},
+		{domain: "com", publicSuffix: "com", registrableDomain: ""},
+		{domain: "example.com", publicSuffix: "com", registrableDomain: "example.com"},
+		{domain: "mail.example.co.uk", publicSuffix: "co.uk", registrableDomain: "example.co.uk"},
+		{domain: "co.uk", publicSuffix: "co.uk", registrableDomain: ""},
+		{domain: "Example.COM.", publicSuffix: "com", registrableDomain: "example.com"},
+		{domain: "example.unlistedtld", publicSuffix: "unlistedtld", registrableDomain: "example.unlistedtld"},
+		// wildcard and exception rules: "*.ck" and "!www.ck"
+		{domain: "a.b.ck", publicSuffix: "b.ck", registrableDomain: "a.b.ck"},
+		{domain: "www.ck", publicSuffix: "ck", registrableDomain: "www.ck"},
+		{domain: "xn--85x722f.xn--55qx5d.cn", publicSuffix: "xn--55qx5d.cn", registrableDomain: "xn--85x722f.xn--55qx5d.cn"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			assert.Equal(t, tt.publicSuffix, publicSuffix(tt.domain))
+			assert.Equal(t, tt.registrableDomain, registrableDomain(tt.domain))
+		})
+	}
+}
+
+func TestParseAddress_PublicSuffix(t *testing.T) {
+	ret := verifier.ParseAddress("user@mail.example.co.uk")
+	assert.Equal(t, "example.co.uk", ret.RegistrableDomain)
+	assert.Equal(t, "co.uk", ret.PublicSuffix)
+
+	ret = verifier.ParseAddress("user@例子.公司.cn")
+	assert.Equal(t, "例子.公司.cn", ret.RegistrableDomain)
+	assert.Equal(t, "公司.cn", ret.PublicSuffix)
+}
+
+func TestIsDisposable_RegistrableDomain(t *testing.T) {
+	assert.True(t, verifier.IsDisposable("mail.zzjbfwqi.shop"))
+	assert.True(t, verifier.IsFreeDomain("mail.123qwe.co.uk"))
+	assert.False(t, verifier.IsFreeDomain("mail.co.uk"))
+}
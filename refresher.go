@@ -0,0 +1,147 @@
+package emailverifier
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// defaultRetryInterval is the delay before retrying a failed refresh by default
+const defaultRetryInterval = time.Minute
+
+// RefreshStatus reports the state of the automatic update of a Verifier
+type RefreshStatus struct {
+	Running     bool      // whether automatic updates are enabled
+	LastSuccess time.Time // when the last successful refresh finished, zero if none
+	LastError   error     // the error of the last refresh, nil if it succeeded
+	LastErrorAt time.Time // when the last failed refresh finished, zero if none
+	Failures    int       // number of consecutive failed refreshes
+}
+
+// refresher calls a job periodically until it is stopped.
+// Intervals are randomized by jitter and failed jobs are retried with exponential backoff.
+type refresher struct {
+	interval      time.Duration // delay between two successful jobs
+	jitter        time.Duration // maximum random delay added to interval
+	retryInterval time.Duration // delay before the first retry of a failed job, doubled for every further failure
+	job           func(context.Context) error
+
+	mu     sync.Mutex
+	state  RefreshStatus
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// newRefresher returns a new refresher calling job every interval
+func newRefresher(interval, jitter, retryInterval time.Duration, job func(context.Context) error) *refresher {
+	if retryInterval <= 0 {
+		retryInterval = defaultRetryInterval
+	}
+	return &refresher{
+		interval:      interval,
+		jitter:        jitter,
+		retryInterval: retryInterval,
+		job:           job,
+	}
+}
+
+// start calls the job periodically in a goroutine until ctx is done or stop is called
+func (r *refresher) start(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cancel != nil {
+		return
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+	r.state.Running = true
+	go r.loop(ctx, r.done)
+}
+
+// stop stops the refresher and waits for a running job to return
+func (r *refresher) stop() {
+	r.mu.Lock()
+	cancel, done := r.cancel, r.done
+	r.cancel, r.done = nil, nil
+	r.state.Running = false
+	r.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// refresh calls the job once and records its outcome
+func (r *refresher) refresh(ctx context.Context) error {
+	err := r.job(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.state.LastError = err
+	if err != nil {
+		r.state.LastErrorAt = time.Now()
+		r.state.Failures++
+		return err
+	}
+	r.state.LastSuccess = time.Now()
+	r.state.Failures = 0
+	return nil
+}
+
+// status returns the current status of the refresher
+func (r *refresher) status() RefreshStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.state
+}
+
+// loop calls the job until ctx is done, the refresher is then marked as stopped unless it has been restarted
+func (r *refresher) loop(ctx context.Context, done chan struct{}) {
+	defer func() {
+		r.mu.Lock()
+		if r.done == done {
+			r.cancel()
+			r.cancel, r.done = nil, nil
+			r.state.Running = false
+		}
+		r.mu.Unlock()
+		close(done)
+	}()
+
+	timer := time.NewTimer(r.nextDelay())
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			_ = r.refresh(ctx)
+			timer.Reset(r.nextDelay())
+		}
+	}
+}
+
+// nextDelay returns the delay before the next job, based on the number of consecutive failures
+func (r *refresher) nextDelay() time.Duration {
+	failures := r.status().Failures
+
+	if failures > 0 {
+		backoff := r.retryInterval
+		for i := 1; i < failures && backoff < r.interval; i++ {
+			backoff *= 2
+		}
+		if backoff < r.interval {
+			return backoff
+		}
+	}
+
+	delay := r.interval
+	if r.jitter > 0 {
+		delay += time.Duration(rand.Int63n(int64(r.jitter))) //nolint:gosec
+	}
+	return delay
+}
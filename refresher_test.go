@@ -0,0 +1,137 @@
+package emailverifier
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefresherOK(t *testing.T) {
+	var ops uint32
+	job := func(ctx context.Context) error {
+		atomic.AddUint32(&ops, 1)
+		return nil
+	}
+
+	r := newRefresher(100*time.Millisecond, 0, 0, job)
+	r.start(context.Background())
+	time.Sleep(350 * time.Millisecond)
+	r.stop()
+
+	actual := atomic.LoadUint32(&ops)
+	assert.Equal(t, uint32(3), actual)
+
+	status := r.status()
+	assert.False(t, status.Running)
+	assert.False(t, status.LastSuccess.IsZero())
+	assert.NoError(t, status.LastError)
+}
+
+func TestRefresher_StopWithContext(t *testing.T) {
+	var ops uint32
+	job := func(ctx context.Context) error {
+		atomic.AddUint32(&ops, 1)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := newRefresher(50*time.Millisecond, 0, 0, job)
+	r.start(ctx)
+	cancel()
+	time.Sleep(120 * time.Millisecond)
+	r.stop()
+
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&ops))
+}
+
+func TestRefresher_ParentContextCanceled(t *testing.T) {
+	r := newRefresher(time.Hour, 0, 0, func(ctx context.Context) error { return nil })
+	ctx, cancel := context.WithCancel(context.Background())
+	r.start(ctx)
+	assert.True(t, r.status().Running)
+
+	r.mu.Lock()
+	done := r.done
+	r.mu.Unlock()
+	cancel()
+	<-done
+	assert.False(t, r.status().Running)
+
+	// the refresher can be started again
+	r.start(context.Background())
+	assert.True(t, r.status().Running)
+	r.stop()
+	assert.False(t, r.status().Running)
+}
+
+func TestRefresher_StopWaitsForJob(t *testing.T) {
+	var finished atomic.Bool
+	job := func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(20 * time.Millisecond)
+		finished.Store(true)
+		return ctx.Err()
+	}
+
+	r := newRefresher(time.Millisecond, 0, 0, job)
+	r.start(context.Background())
+	time.Sleep(20 * time.Millisecond)
+	r.stop()
+
+	assert.True(t, finished.Load())
+}
+
+func TestRefresher_StopNotStarted(t *testing.T) {
+	r := newRefresher(time.Minute, 0, 0, func(ctx context.Context) error { return nil })
+	assert.NotPanics(t, r.stop)
+	assert.NotPanics(t, r.stop)
+}
+
+func TestRefresher_Failure(t *testing.T) {
+	errUpdate := errors.New("update failed")
+	r := newRefresher(time.Hour, 0, time.Second, func(ctx context.Context) error { return errUpdate })
+
+	assert.Equal(t, errUpdate, r.refresh(context.Background()))
+	assert.Equal(t, errUpdate, r.refresh(context.Background()))
+
+	status := r.status()
+	assert.Equal(t, errUpdate, status.LastError)
+	assert.Equal(t, 2, status.Failures)
+	assert.False(t, status.LastErrorAt.IsZero())
+	assert.True(t, status.LastSuccess.IsZero())
+}
+
+func TestRefresher_NextDelay(t *testing.T) {
+	var fail atomic.Bool
+	r := newRefresher(time.Hour, 0, time.Minute, func(ctx context.Context) error {
+		if fail.Load() {
+			return errors.New("update failed")
+		}
+		return nil
+	})
+	assert.Equal(t, time.Hour, r.nextDelay())
+
+	// exponential backoff on consecutive failures, capped at the interval
+	fail.Store(true)
+	expected := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 16 * time.Minute, 32 * time.Minute, time.Hour, time.Hour}
+	for _, delay := range expected {
+		_ = r.refresh(context.Background())
+		assert.Equal(t, delay, r.nextDelay())
+	}
+
+	fail.Store(false)
+	_ = r.refresh(context.Background())
+	assert.Equal(t, time.Hour, r.nextDelay())
+}
+
+func TestRefresher_Jitter(t *testing.T) {
+	r := newRefresher(time.Hour, time.Minute, 0, func(ctx context.Context) error { return nil })
+	for i := 0; i < 100; i++ {
+		delay := r.nextDelay()
+		assert.True(t, delay >= time.Hour && delay < time.Hour+time.Minute, "delay %s out of range", delay)
+	}
+}
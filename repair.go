@@ -0,0 +1,115 @@
+package emailverifier
+
+import (
+	"context"
+	"strings"
+	"unicode"
+)
+
+// addressPunctuation is stripped from both ends of an address, e.g. when copied from a sentence or a mail header
+const addressPunctuation = ".,;:!?'\"<>()[]"
+
+// SuggestAddress repairs the typos which make an address invalid and corrects its domain, such as
+// a missing "@" ("john.gmail.com"), a missing dot before the top level domain ("john@gmailcom"),
+// doubled symbols ("john@@gmail.com"), a comma instead of a dot, whitespace and surrounding punctuation.
+// It returns the corrected address, or an empty string if the address cannot be repaired or needs no correction.
+func (v *Verifier) SuggestAddress(email string) string {
+	return v.suggestAddress(context.Background(), email)
+}
+
+// suggestAddress returns the repaired and corrected email, empty if it cannot be repaired or needs no correction
+func (v *Verifier) suggestAddress(ctx context.Context, email string) string {
+	repaired := v.repairAddress(email)
+	syntax := v.ParseAddress(repaired)
+	if !syntax.Valid {
+		return ""
+	}
+
+	if suggestions := v.suggestDomains(ctx, syntax.Domain, 1); len(suggestions) > 0 {
+		repaired = repaired[:strings.LastIndex(repaired, "@")+1] + suggestions[0].Domain
+	}
+	if repaired == email {
+		return ""
+	}
+	return repaired
+}
+
+// repairAddress fixes the syntax typos of email, the result may still be invalid
+func (v *Verifier) repairAddress(email string) string {
+	email = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, email)
+	if len(email) > len("mailto:") && strings.EqualFold(email[:len("mailto:")], "mailto:") {
+		email = email[len("mailto:"):]
+	}
+	email = strings.Trim(email, addressPunctuation)
+	email = collapseSymbols(email)
+
+	index := strings.LastIndex(email, "@")
+	if index < 0 {
+		return v.insertAt(email)
+	}
+	username, domain := email[:index], strings.ToLower(email[index+1:])
+	domain = strings.ReplaceAll(domain, ",", ".")
+	if !strings.Contains(domain, ".") {
+		domain = v.insertTLDDot(domain)
+	}
+	return username + "@" + domain
+}
+
+// collapseSymbols removes the doubled "@" and "." of email, as well as the dots next to the "@"
+func collapseSymbols(email string) string {
+	for _, r := range []struct{ old, new string }{{"@@", "@"}, {"..", "."}, {".@", "@"}, {"@.", "@"}} {
+		for strings.Contains(email, r.old) {
+			email = strings.ReplaceAll(email, r.old, r.new)
+		}
+	}
+	return email
+}
+
+// insertAt inserts the missing "@" of email in place of the dot in front of the longest known domain it ends with,
+// such as "john.gmail.com", or when there is no dot before it, in front of a known registrable domain,
+// such as "johngmail.com". The "@" is never inserted in the middle of a dotted address such as "john.bigcorpmail.com".
+func (v *Verifier) insertAt(email string) string {
+	corpus := v.domainCorpus()
+	for i := 1; i < len(email); i++ {
+		domain := strings.ToLower(email[i:])
+		if !corpus.domains[domain] {
+			continue
+		}
+		if email[i-1] == '.' && i > 1 {
+			return email[:i-1] + "@" + domain
+		}
+		if !strings.Contains(email[:i], ".") && registrableDomain(domain) == domain {
+			return email[:i] + "@" + domain
+		}
+	}
+	return email
+}
+
+// insertTLDDot inserts the missing dot in front of the top level domain of domain, such as "gmailcom",
+// preferring a split which gives a known domain, then the longest known top level domain
+func (v *Verifier) insertTLDDot(domain string) string {
+	corpus := v.domainCorpus()
+	best, bestKnown, bestTLD := "", false, ""
+	for tld := range v.levelCorpora().topLevel.domains {
+		suffix := strings.ReplaceAll(tld, ".", "")
+		if len(domain) <= len(suffix) || !strings.HasSuffix(domain, suffix) {
+			continue
+		}
+		candidate := domain[:len(domain)-len(suffix)] + "." + tld
+		known := corpus.domains[candidate]
+		better := best == "" || (known && !bestKnown) ||
+			(known == bestKnown && (len(tld) > len(bestTLD) || len(tld) == len(bestTLD) && tld < bestTLD))
+		if better {
+			best, bestKnown, bestTLD = candidate, known, tld
+		}
+	}
+	if best == "" {
+		return domain
+	}
+	return best
+}
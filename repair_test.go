@@ -0,0 +1,79 @@
+package emailverifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestAddress(t *testing.T) {
+	cases := []struct {
+		email, expected string
+	}{
+		{"john@gmailcom", "john@gmail.com"},
+		{"john@yahoocouk", "john@yahoo.co.uk"},
+		{"john@examplenet", "john@example.net"},
+		{"john.gmail.com", "john@gmail.com"},
+		{"john.smith.gmail.com", "john.smith@gmail.com"},
+		{"johngmail.com", "john@gmail.com"},
+		{"john@@gmail.com", "john@gmail.com"},
+		{"john..smith@gmail.com", "john.smith@gmail.com"},
+		{"john.@gmail.com", "john@gmail.com"},
+		{"john@.gmail.com", "john@gmail.com"},
+		{"john@gmail,com", "john@gmail.com"},
+		{" john @gmail.com ", "john@gmail.com"},
+		{"<john@gmail.com>.", "john@gmail.com"},
+		{"mailto:john@gmail.com", "john@gmail.com"},
+		{"John@GMAILCOM", "John@gmail.com"},
+		{"john@gmai.com", "john@gmail.com"},
+		{"john@@gmai.com", "john@gmail.com"},
+		// nothing to correct
+		{"john@gmail.com", ""},
+		{"john@example.org", ""},
+		// cannot be repaired
+		{"john", ""},
+		{"john.example.org", ""},
+		{"", ""},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, verifier.SuggestAddress(c.email), c.email)
+	}
+}
+
+func TestSuggestAddress_RegisteredCorpus(t *testing.T) {
+	v := NewVerifier().RegisterSuggestionCorpus(map[string]float64{"bigcorpmail.com": 1})
+
+	assert.Equal(t, "john@bigcorpmail.com", v.SuggestAddress("john.bigcorpmail.com"))
+	assert.Empty(t, verifier.SuggestAddress("john.bigcorpmail.com"))
+}
+
+func TestInsertAt(t *testing.T) {
+	assert.Equal(t, "John.Smith@gmail.com", verifier.insertAt("John.Smith.GMAIL.com"))
+	assert.Equal(t, "JOHN@gmail.com", verifier.insertAt("JOHNGMAIL.COM"))
+	// the "@" replaces a dot, or starts a registrable domain
+	assert.Equal(t, "john.bigcorpmail.com", verifier.insertAt("john.bigcorpmail.com"))
+	assert.Equal(t, "john.smithgmail.com", verifier.insertAt("john.smithgmail.com"))
+	// the lower case form of "İ" is longer, indexes of the lower case string do not apply to email
+	assert.Equal(t, "İ.john@gmail.com", verifier.insertAt("İ.john.gmail.com"))
+}
+
+func TestInsertTLDDot(t *testing.T) {
+	assert.Equal(t, "gmail.com", verifier.insertTLDDot("gmailcom"))
+	assert.Equal(t, "example.com.au", verifier.insertTLDDot("examplecomau"))
+	assert.Equal(t, "example", verifier.insertTLDDot("example"))
+	assert.Equal(t, "com", verifier.insertTLDDot("com"))
+}
+
+func TestCheckEmail_AddressSuggestion(t *testing.T) {
+	v := NewVerifier().EnableDomainSuggest()
+
+	ret, err := v.Verify(context.Background(), "john@@gmail.com")
+	assert.NoError(t, err)
+	assert.False(t, ret.Syntax.Valid)
+	assert.Equal(t, "john@gmail.com", ret.AddressSuggestion)
+
+	ret, err = NewVerifier().Verify(context.Background(), "john@@gmail.com")
+	assert.NoError(t, err)
+	assert.Empty(t, ret.AddressSuggestion)
+}
@@ -0,0 +1,111 @@
+package emailverifier
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// entrySet is a read-only set of list entries
+type entrySet interface {
+	has(entry string) bool
+	len() int
+	each(fn func(entry string))
+}
+
+// mapSet is an entrySet backed by a map, used for the small embedded lists
+type mapSet map[string]bool
+
+func (s mapSet) has(entry string) bool { return s[entry] }
+
+func (s mapSet) len() int { return len(s) }
+
+func (s mapSet) each(fn func(entry string)) {
+	for e := range s {
+		fn(e)
+	}
+}
+
+// compactSet is an immutable entrySet stored as a single string of sorted entries, each followed by a newline.
+// Compared to a map it takes a fraction of the memory and, when generated as a constant, costs nothing at startup.
+// The offsets of the entries are computed on first use, lookups binary search them.
+type compactSet struct {
+	data string
+
+	once    sync.Once
+	offsets []uint32 // start of every entry in data
+}
+
+// newCompactSet creates a set from data holding sorted entries separated by newlines,
+// a leading newline such as the one of a raw string literal is ignored
+func newCompactSet(data string) *compactSet {
+	return &compactSet{data: strings.TrimPrefix(data, "\n")}
+}
+
+// buildCompactSet creates a set from unsorted entries, duplicates and entries containing newlines are dropped
+func buildCompactSet(entries []string) *compactSet {
+	sorted := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e != "" && !strings.Contains(e, "\n") {
+			sorted = append(sorted, e)
+		}
+	}
+	sort.Strings(sorted)
+
+	var b strings.Builder
+	for i, e := range sorted {
+		if i > 0 && e == sorted[i-1] {
+			continue
+		}
+		b.WriteString(e)
+		b.WriteByte('\n')
+	}
+	return newCompactSet(b.String())
+}
+
+// index computes the offsets of the entries
+func (s *compactSet) index() {
+	s.once.Do(func() {
+		offsets := make([]uint32, 0, strings.Count(s.data, "\n"))
+		start := 0
+		for start < len(s.data) {
+			end := strings.IndexByte(s.data[start:], '\n')
+			if end == -1 {
+				end = len(s.data) - start
+			}
+			offsets = append(offsets, uint32(start))
+			start += end + 1
+		}
+		s.offsets = offsets
+	})
+}
+
+// entry returns the i-th entry, index must have been called
+func (s *compactSet) entry(i int) string {
+	start := int(s.offsets[i])
+	end := len(s.data)
+	if i+1 < len(s.offsets) {
+		end = int(s.offsets[i+1])
+	}
+	return strings.TrimSuffix(s.data[start:end], "\n")
+}
+
+func (s *compactSet) has(entry string) bool {
+	s.index()
+	i := sort.Search(len(s.offsets), func(i int) bool {
+		return s.entry(i) >= entry
+	})
+	return i < len(s.offsets) && s.entry(i) == entry
+}
+
+func (s *compactSet) len() int {
+	s.index()
+	return len(s.offsets)
+}
+
+func (s *compactSet) each(fn func(entry string)) {
+	s.index()
+	for i := range s.offsets {
+		fn(s.entry(i))
+	}
+}
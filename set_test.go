@@ -0,0 +1,117 @@
+package emailverifier
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactSet(t *testing.T) {
+	s := buildCompactSet([]string{"c.com", "a.com", "b.com", "a.com", "", "x\ny.com"})
+
+	assert.Equal(t, 3, s.len())
+	assert.True(t, s.has("a.com"))
+	assert.True(t, s.has("b.com"))
+	assert.True(t, s.has("c.com"))
+	assert.False(t, s.has("b"))
+	assert.False(t, s.has("b.co"))
+	assert.False(t, s.has("d.com"))
+	assert.False(t, s.has(""))
+
+	var entries []string
+	s.each(func(e string) { entries = append(entries, e) })
+	assert.Equal(t, []string{"a.com", "b.com", "c.com"}, entries)
+}
+
+func TestCompactSet_Generated(t *testing.T) {
+	s := newCompactSet("\na.com\nb.com\n")
+	assert.Equal(t, 2, s.len())
+	assert.True(t, s.has("a.com"))
+	assert.False(t, s.has(""))
+
+	empty := newCompactSet("")
+	assert.Equal(t, 0, empty.len())
+	assert.False(t, empty.has("a.com"))
+}
+
+func TestCompactSet_Embedded(t *testing.T) {
+	assert.Greater(t, disposableDomains.len(), 1000)
+	assert.True(t, disposableDomains.has("mailinator.com"))
+	assert.False(t, disposableDomains.has("gmail.com"))
+
+	var prev string
+	disposableDomains.each(func(e string) {
+		assert.Less(t, prev, e)
+		prev = e
+	})
+}
+
+// disposableEntries returns the embedded disposable domains
+func disposableEntries() []string {
+	var entries []string
+	disposableDomains.each(func(e string) { entries = append(entries, e) })
+	return entries
+}
+
+// heapGrowth returns the heap memory retained by the value built by fn
+func heapGrowth(fn func() any) uint64 {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	v := fn()
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(v)
+	return after.HeapAlloc - before.HeapAlloc
+}
+
+func BenchmarkDisposableSet_Memory(b *testing.B) {
+	entries := disposableEntries()
+	b.Run("map", func(b *testing.B) {
+		var size uint64
+		for i := 0; i < b.N; i++ {
+			size = heapGrowth(func() any {
+				m := make(mapSet, len(entries))
+				for _, e := range entries {
+					m[e] = true
+				}
+				return m
+			})
+		}
+		b.ReportMetric(float64(size), "heap-bytes")
+	})
+	b.Run("compact", func(b *testing.B) {
+		var size uint64
+		for i := 0; i < b.N; i++ {
+			size = heapGrowth(func() any {
+				s := buildCompactSet(entries)
+				s.index()
+				return s
+			})
+		}
+		b.ReportMetric(float64(size), "heap-bytes")
+	})
+}
+
+func BenchmarkDisposableSet_Lookup(b *testing.B) {
+	entries := disposableEntries()
+	m := make(mapSet, len(entries))
+	for _, e := range entries {
+		m[e] = true
+	}
+	s := buildCompactSet(entries)
+	queries := []string{entries[len(entries)/3], "gmail.com", entries[len(entries)-1], "unknown.example"}
+
+	for _, set := range []struct {
+		name string
+		set  entrySet
+	}{{"map", m}, {"compact", s}} {
+		b.Run(set.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				set.set.has(queries[i%len(queries)])
+			}
+		})
+	}
+}
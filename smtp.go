@@ -8,7 +8,6 @@ import (
 	"net"
 	"net/smtp"
 	"net/url"
-	"strings"
 	"sync"
 	"time"
 
@@ -35,11 +34,26 @@ func (v *Verifier) CheckSMTP(ctx context.Context, domain, username string) (*SMT
 	}
 
 	var ret SMTP
-	var err error
-	email := fmt.Sprintf("%s@%s", username, domain)
+	asciiDomain, err := toASCII(domain)
+	if err != nil {
+		return nil, fmt.Errorf("invalid domain: %w", err)
+	}
+	email := fmt.Sprintf("%s@%s", username, asciiDomain)
+
+	mxRecords, err := v.resolver.LookupMX(ctx, asciiDomain)
+	if err != nil {
+		return &ret, ParseSMTPError(err)
+	}
+
+	// Check by api when enabled and the preferred MX host recognized, port 25 is not needed then
+	if len(v.apiVerifiers) > 0 && len(mxRecords) > 0 {
+		if apiVerifier := v.apiVerifierFor(preferredMX(mxRecords).Host); apiVerifier != nil {
+			return apiVerifier.Check(ctx, v.apiClient, asciiDomain, username)
+		}
+	}
 
 	// Dial any SMTP server that will accept a connection
-	client, mx, err := newSMTPClient(ctx, domain, v.proxyURI, v.connectTimeout, v.operationTimeout)
+	client, mx, err := newSMTPClient(mxRecords, v.proxyURI, v.connectTimeout, v.operationTimeout)
 	if err != nil {
 		return &ret, ParseSMTPError(err)
 	}
@@ -47,18 +61,19 @@ func (v *Verifier) CheckSMTP(ctx context.Context, domain, username string) (*SMT
 	// Defer quit the SMTP connection
 	defer client.Close()
 
-	// Check by api when enabled and host recognized.
-	for _, apiVerifier := range v.apiVerifiers {
-		if apiVerifier.isSupported(strings.ToLower(mx.Host)) {
-			return apiVerifier.check(domain, username)
-		}
-	}
-
 	// Sets the HELO/EHLO hostname
 	if err = client.Hello(v.helloName); err != nil {
 		return &ret, ParseSMTPError(err)
 	}
 
+	// A non-ASCII local part can only be sent to servers supporting SMTPUTF8, see RFC 6531
+	if !isASCII(username) {
+		if ok, _ := client.Extension("SMTPUTF8"); !ok {
+			ret.HostExists = true
+			return &ret, newLookupError(ErrSMTPUTF8Unsupported, mx.Host)
+		}
+	}
+
 	// Sets the from email
 	if err = client.Mail(v.fromEmail); err != nil {
 		return &ret, ParseSMTPError(err)
@@ -73,7 +88,7 @@ func (v *Verifier) CheckSMTP(ctx context.Context, domain, username string) (*SMT
 	if v.catchAllCheckEnabled {
 		// Checks the deliver ability of a randomly generated address in
 		// order to verify the existence of a catch-all and etc.
-		randomEmail := GenerateRandomEmail(domain)
+		randomEmail := GenerateRandomEmail(asciiDomain)
 		if err = client.Rcpt(randomEmail); err != nil {
 			if e := ParseSMTPError(err); e != nil {
 				switch e.Message {
@@ -111,14 +126,20 @@ func (v *Verifier) CheckSMTP(ctx context.Context, domain, username string) (*SMT
 	return &ret, nil
 }
 
-// newSMTPClient generates a new available SMTP client
-func newSMTPClient(ctx context.Context, domain, proxyURI string, connectTimeout, operationTimeout time.Duration) (*smtp.Client, *net.MX, error) {
-	domain = domainToASCII(domain)
-	mxRecords, err := net.DefaultResolver.LookupMX(ctx, domain)
-	if err != nil {
-		return nil, nil, err
+// preferredMX returns the MX record with the lowest preference of the non-empty records,
+// the first of them on ties, whatever the order the resolver returned them in
+func preferredMX(mxRecords []*net.MX) *net.MX {
+	preferred := mxRecords[0]
+	for _, r := range mxRecords[1:] {
+		if r.Pref < preferred.Pref {
+			preferred = r
+		}
 	}
+	return preferred
+}
 
+// newSMTPClient generates a new available SMTP client connected to one of the MX hosts
+func newSMTPClient(mxRecords []*net.MX, proxyURI string, connectTimeout, operationTimeout time.Duration) (*smtp.Client, *net.MX, error) {
 	if len(mxRecords) == 0 {
 		return nil, nil, errors.New("No MX records found")
 	}
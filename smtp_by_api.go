@@ -1,12 +1,78 @@
 package emailverifier
 
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+)
+
 const (
-	YAHOO = "yahoo"
+	YAHOO     = "yahoo"
+	MICROSOFT = "microsoft" // Outlook.com, Hotmail and Live consumer addresses
 )
 
-type smtpAPIVerifier interface {
-	// isSupported the specific host supports the check by api.
-	isSupported(host string) bool
-	// check must be called before isSupported == true
-	check(domain, username string) (*SMTP, error)
+// APIVerifier checks whether an email address exists through the API of its provider instead of SMTP,
+// it is used for the domains whose preferred MX host matches one of its patterns
+type APIVerifier interface {
+	// MXHostPatterns returns the MX hosts of the provider, such as "mx.example.com",
+	// a leading "*." matches every subdomain, such as "*.yahoodns.net"
+	MXHostPatterns() []string
+	// Check checks whether username@domain exists, sending its requests with client
+	Check(ctx context.Context, client *http.Client, domain, username string) (*SMTP, error)
+}
+
+// apiVerifierFactories create the built-in API verifiers enabled by EnableAPIVerifier
+var apiVerifierFactories = map[string]func() APIVerifier{
+	YAHOO:     newYahooAPIVerifier,
+	MICROSOFT: newMicrosoftAPIVerifier,
+}
+
+// RegisterAPIVerifier registers and enables the API verifier impl under name, replacing any verifier of that name,
+// a nil impl disables the verifier of that name like DisableAPIVerifier
+func (v *Verifier) RegisterAPIVerifier(name string, impl APIVerifier) *Verifier {
+	if impl == nil {
+		delete(v.apiVerifiers, name)
+		return v
+	}
+	v.apiVerifiers[name] = impl
+	return v
+}
+
+// APIClient sets the HTTP client used by the API verifiers, http.DefaultClient when nil
+func (v *Verifier) APIClient(client *http.Client) *Verifier {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	v.apiClient = client
+	return v
+}
+
+// apiVerifierFor returns the enabled API verifier of the MX host, the first by name if several match,
+// nil if none
+func (v *Verifier) apiVerifierFor(host string) APIVerifier {
+	names := make([]string, 0, len(v.apiVerifiers))
+	for name := range v.apiVerifiers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, pattern := range v.apiVerifiers[name].MXHostPatterns() {
+			if matchMXHost(pattern, host) {
+				return v.apiVerifiers[name]
+			}
+		}
+	}
+	return nil
+}
+
+// matchMXHost reports whether the MX host matches pattern, a leading "*." matches every subdomain
+func matchMXHost(pattern, host string) bool {
+	pattern = strings.TrimSuffix(strings.ToLower(pattern), ".")
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+		return strings.HasSuffix(host, "."+suffix)
+	}
+	return host == pattern
 }
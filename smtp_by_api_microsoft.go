@@ -0,0 +1,139 @@
+package emailverifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	credentialTypeEndpoint = "https://login.microsoftonline.com/common/GetCredentialType?mkt=en-US"
+
+	// microsoftRequestTimeout bounds every request to Microsoft, on top of the deadline of the caller
+	microsoftRequestTimeout = 10 * time.Second
+)
+
+// Values of IfExistsResult and ThrottleStatus in the GetCredentialType response
+const (
+	microsoftAccountExists         = 0 // the account exists
+	microsoftAccountNotExists      = 1 // no account has the address
+	microsoftAccountExistsOtherIDP = 5 // the address is a personal account of another identity provider
+	microsoftAccountExistsBothIDPs = 6 // the address is both a personal and a work or school account
+	microsoftThrottled             = 1 // ThrottleStatus of a rate limited request
+)
+
+// microsoftMXHostPatterns are the MX hosts of the consumer domains of Microsoft: outlook.com, hotmail.com, live.com...
+// Work and school domains use *.mail.protection.outlook.com and are not supported.
+var microsoftMXHostPatterns = []string{"*.olc.protection.outlook.com"}
+
+// MicrosoftAPIConfig configures the Microsoft API verifier, an empty URL is replaced by the one of Microsoft
+type MicrosoftAPIConfig struct {
+	CredentialTypeURL string // endpoint telling which credentials an account signs in with, if it exists
+}
+
+// NewMicrosoftAPIVerifier creates an API verifier checking Outlook.com, Hotmail and Live email exists
+// by the credential type lookup of the Microsoft login page,
+// it replaces the built-in one when registered with RegisterAPIVerifier(MICROSOFT, ...).
+// The lookup is not a documented API: how it answers for personal accounts has not been checked
+// against recorded responses, so its results are a hint rather than a proof of deliverability.
+// See https://login.microsoftonline.com
+func NewMicrosoftAPIVerifier(config MicrosoftAPIConfig) APIVerifier {
+	if config.CredentialTypeURL == "" {
+		config.CredentialTypeURL = credentialTypeEndpoint
+	}
+	return microsoft{credentialTypeURL: config.CredentialTypeURL}
+}
+
+// newMicrosoftAPIVerifier creates the Microsoft API verifier enabled by EnableAPIVerifier
+func newMicrosoftAPIVerifier() APIVerifier {
+	return NewMicrosoftAPIVerifier(MicrosoftAPIConfig{})
+}
+
+type microsoft struct {
+	credentialTypeURL string
+}
+
+type microsoftCredentialTypeReq struct {
+	Username             string `json:"username"`
+	IsOtherIdpSupported  bool   `json:"isOtherIdpSupported"`
+	CheckPhones          bool   `json:"checkPhones"`
+	IsRemoteNGCSupported bool   `json:"isRemoteNGCSupported"`
+	IsCookieBannerShown  bool   `json:"isCookieBannerShown"`
+	IsFidoSupported      bool   `json:"isFidoSupported"`
+	ForceOTCLogin        bool   `json:"forceotclogin"`
+	IsSignup             bool   `json:"isSignup"`
+}
+
+type microsoftCredentialTypeResp struct {
+	Username       string `json:"Username"`
+	IfExistsResult int    `json:"IfExistsResult"`
+	ThrottleStatus int    `json:"ThrottleStatus"`
+}
+
+func (m microsoft) MXHostPatterns() []string {
+	return microsoftMXHostPatterns
+}
+
+func (m microsoft) Check(ctx context.Context, client *http.Client, domain, username string) (*SMTP, error) {
+	resp, err := m.getCredentialType(ctx, client, username+"@"+domain)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ThrottleStatus == microsoftThrottled {
+		return nil, errors.New("microsoft check by api, throttled")
+	}
+
+	switch resp.IfExistsResult {
+	case microsoftAccountExists, microsoftAccountExistsOtherIDP, microsoftAccountExistsBothIDPs:
+		return &SMTP{HostExists: true, Deliverable: true}, nil
+	case microsoftAccountNotExists:
+		return &SMTP{HostExists: true, Deliverable: false}, nil
+	default:
+		return nil, fmt.Errorf("microsoft check by api, unknown IfExistsResult %d", resp.IfExistsResult)
+	}
+}
+
+func (m microsoft) getCredentialType(ctx context.Context, client *http.Client, email string) (microsoftCredentialTypeResp, error) {
+	var res microsoftCredentialTypeResp
+	data, err := json.Marshal(microsoftCredentialTypeReq{
+		Username:             email,
+		IsOtherIdpSupported:  true,
+		IsRemoteNGCSupported: true,
+		IsFidoSupported:      true,
+	})
+	if err != nil {
+		return res, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, microsoftRequestTimeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, m.credentialTypeURL, bytes.NewReader(data))
+	if err != nil {
+		return res, err
+	}
+	request.Header.Add("User-Agent", userAgent)
+	request.Header.Add("Content-Type", "application/json; charset=UTF-8")
+	resp, err := client.Do(request)
+	if err != nil {
+		return res, err
+	}
+	defer resp.Body.Close()
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return res, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return res, fmt.Errorf("microsoft check by api, status %d", resp.StatusCode)
+	}
+	if !json.Valid(respBytes) {
+		return res, errors.New("microsoft response is not valid JSON")
+	}
+	err = json.Unmarshal(respBytes, &res)
+	return res, err
+}
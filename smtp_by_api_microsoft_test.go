@@ -0,0 +1,118 @@
+package emailverifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Hand-written GetCredentialType responses, they are not captures of real responses.
+// They only hold the fields the verifier reads, see microsoftCredentialTypeResp.
+const (
+	microsoftExistsResp         = `{"Username":"taken@outlook.com","IfExistsResult":0,"ThrottleStatus":0}`
+	microsoftExistsOtherIDPResp = `{"Username":"personal@outlook.com","IfExistsResult":5,"ThrottleStatus":0}`
+	microsoftNotExistsResp      = `{"Username":"available@outlook.com","IfExistsResult":1,"ThrottleStatus":0}`
+	microsoftThrottledResp      = `{"Username":"throttled@outlook.com","IfExistsResult":0,"ThrottleStatus":1}`
+)
+
+// newMicrosoftStub serves the hand-written GetCredentialType responses by username
+func newMicrosoftStub(t *testing.T) *httptest.Server {
+	responses := map[string]string{
+		"taken@outlook.com":     microsoftExistsResp,
+		"personal@outlook.com":  microsoftExistsOtherIDPResp,
+		"available@outlook.com": microsoftNotExistsResp,
+		"throttled@outlook.com": microsoftThrottledResp,
+		"unknown@outlook.com":   `{"Username":"unknown@outlook.com","IfExistsResult":4,"ThrottleStatus":0}`,
+		"html@outlook.com":      `<html><body>Sign in</body></html>`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req microsoftCredentialTypeReq
+		if r.Method != http.MethodPost || r.URL.Path != "/common/GetCredentialType" ||
+			json.NewDecoder(r.Body).Decode(&req) != nil || !req.IsOtherIdpSupported {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resp, ok := responses[req.Username]
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = io.WriteString(w, resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestMicrosoftCheckByAPI_Stub(t *testing.T) {
+	server := newMicrosoftStub(t)
+	m := NewMicrosoftAPIVerifier(MicrosoftAPIConfig{CredentialTypeURL: server.URL + "/common/GetCredentialType?mkt=en-US"})
+
+	cases := []struct {
+		username string
+		expected *SMTP
+		err      string
+	}{
+		{username: "taken", expected: &SMTP{HostExists: true, Deliverable: true}},
+		{username: "personal", expected: &SMTP{HostExists: true, Deliverable: true}},
+		{username: "available", expected: &SMTP{HostExists: true, Deliverable: false}},
+		{username: "throttled", err: "microsoft check by api, throttled"},
+		{username: "unknown", err: "microsoft check by api, unknown IfExistsResult 4"},
+		{username: "html", err: "microsoft response is not valid JSON"},
+		{username: "error", err: "microsoft check by api, status 500"},
+	}
+	for _, c := range cases {
+		t.Run(c.username, func(t *testing.T) {
+			res, err := m.Check(context.Background(), server.Client(), "outlook.com", c.username)
+			if c.err != "" {
+				assert.EqualError(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, res)
+		})
+	}
+}
+
+func TestMicrosoftCheckByAPI_Verifier(t *testing.T) {
+	server := newMicrosoftStub(t)
+	resolver := stubResolver{
+		"outlook.com": {{Host: "outlook-com.olc.protection.outlook.com.", Pref: 5}},
+		"hotmail.com": {{Host: "hotmail-com.olc.protection.outlook.com.", Pref: 2}},
+	}
+	v := NewVerifier().
+		EnableSMTPCheck().
+		UseResolver(resolver).
+		APIClient(server.Client()).
+		RegisterAPIVerifier(MICROSOFT, NewMicrosoftAPIVerifier(MicrosoftAPIConfig{
+			CredentialTypeURL: server.URL + "/common/GetCredentialType",
+		}))
+
+	res, err := v.CheckSMTP(context.Background(), "outlook.com", "taken")
+	require.NoError(t, err)
+	assert.True(t, res.Deliverable)
+	assert.NotNil(t, v.apiVerifierFor("hotmail-com.olc.protection.outlook.com."))
+	// work and school domains are hosted on other MX hosts
+	assert.Nil(t, v.apiVerifierFor("example-com.mail.protection.outlook.com."))
+}
+
+func TestEnableAPIVerifier_Microsoft(t *testing.T) {
+	v := NewVerifier()
+	require.NoError(t, v.EnableAPIVerifier(MICROSOFT))
+	assert.Equal(t, microsoft{credentialTypeURL: credentialTypeEndpoint}, v.apiVerifierFor("outlook-com.olc.protection.outlook.com."))
+}
+
+func TestMicrosoftCheckByAPI_Context(t *testing.T) {
+	server := newMicrosoftStub(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	m := NewMicrosoftAPIVerifier(MicrosoftAPIConfig{CredentialTypeURL: server.URL + "/common/GetCredentialType"})
+	_, err := m.Check(ctx, server.Client(), "outlook.com", "taken")
+	assert.ErrorIs(t, err, context.Canceled)
+}
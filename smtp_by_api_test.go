@@ -0,0 +1,141 @@
+package emailverifier
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAPIVerifier reports every address of its MX hosts as deliverable
+type fakeAPIVerifier struct {
+	patterns []string
+	client   *http.Client // client of the last check
+	domain   string       // domain of the last check
+}
+
+func (f *fakeAPIVerifier) MXHostPatterns() []string {
+	return f.patterns
+}
+
+func (f *fakeAPIVerifier) Check(_ context.Context, client *http.Client, domain, username string) (*SMTP, error) {
+	f.client, f.domain = client, domain
+	return &SMTP{HostExists: true, Deliverable: username != ""}, nil
+}
+
+func TestMatchMXHost(t *testing.T) {
+	assert.True(t, matchMXHost("*.yahoodns.net", "mta5.am0.yahoodns.net."))
+	assert.True(t, matchMXHost("*.yahoodns.net", "MTA5.AM0.YAHOODNS.NET"))
+	assert.True(t, matchMXHost("mx.example.com", "mx.example.com."))
+	assert.False(t, matchMXHost("*.yahoodns.net", "yahoodns.net"))
+	assert.False(t, matchMXHost("*.yahoodns.net", "mx.notyahoodns.net"))
+	assert.False(t, matchMXHost("mx.example.com", "mx2.example.com"))
+	// the host merely containing the vendor name is not enough
+	assert.False(t, matchMXHost(yahooMXHostPatterns[0], "yahoo.example.com"))
+}
+
+func TestRegisterAPIVerifier(t *testing.T) {
+	fake := &fakeAPIVerifier{patterns: []string{"*.mail.example.org"}}
+	client := &http.Client{}
+	resolver := stubResolver{"example.org": {{Host: "mx1.mail.example.org.", Pref: 10}}}
+	v := NewVerifier().
+		UseResolver(resolver).
+		EnableSMTPCheck().
+		APIClient(client).
+		RegisterAPIVerifier("example", fake)
+
+	smtp, err := v.CheckSMTP(context.Background(), "example.org", "john")
+	assert.NoError(t, err)
+	assert.Equal(t, &SMTP{HostExists: true, Deliverable: true}, smtp)
+	assert.Same(t, client, fake.client)
+
+	v.DisableAPIVerifier("example")
+	assert.Nil(t, v.apiVerifierFor("mx1.mail.example.org"))
+
+	v.RegisterAPIVerifier("example", fake).RegisterAPIVerifier("example", nil)
+	assert.Nil(t, v.apiVerifierFor("mx1.mail.example.org"))
+	assert.NotContains(t, v.apiVerifiers, "example")
+}
+
+func TestCheckSMTP_LooksUpMXOnce(t *testing.T) {
+	resolver := &countingResolver{Resolver: stubResolver{"example.org": {{Host: "localhost.", Pref: 10}}}}
+	v := NewVerifier().
+		UseResolver(resolver).
+		EnableSMTPCheck().
+		ConnectTimeout(time.Second).
+		RegisterAPIVerifier("example", &fakeAPIVerifier{patterns: []string{"*.mail.example.org"}})
+
+	// no API verifier matches localhost, the MX records are reused to dial the SMTP servers
+	_, _ = v.CheckSMTP(context.Background(), "example.org", "john")
+	assert.Equal(t, 1, resolver.lookups())
+}
+
+func TestCheckSMTP_PreferredMXHost(t *testing.T) {
+	fake := &fakeAPIVerifier{patterns: []string{"*.mail.example.org"}}
+	// the records are not sorted by preference
+	resolver := stubResolver{"xn--d1aca0agade.org": {
+		{Host: "backup.example.net.", Pref: 20},
+		{Host: "mx1.mail.example.org.", Pref: 5},
+		{Host: "mx2.example.net.", Pref: 10},
+	}}
+	v := NewVerifier().
+		UseResolver(resolver).
+		EnableSMTPCheck().
+		RegisterAPIVerifier("example", fake)
+
+	smtp, err := v.CheckSMTP(context.Background(), "доменное.org", "john")
+	assert.NoError(t, err)
+	assert.Equal(t, &SMTP{HostExists: true, Deliverable: true}, smtp)
+	// the API verifier is given the domain in its ASCII form, like the SMTP servers
+	assert.Equal(t, "xn--d1aca0agade.org", fake.domain)
+}
+
+func TestPreferredMX(t *testing.T) {
+	records := []*net.MX{{Host: "a.", Pref: 20}, {Host: "b.", Pref: 10}, {Host: "c.", Pref: 10}}
+	assert.Same(t, records[1], preferredMX(records))
+	assert.Same(t, records[0], preferredMX(records[:1]))
+}
+
+// countingResolver counts the lookups of its Resolver
+type countingResolver struct {
+	Resolver
+
+	mu sync.Mutex
+	n  int
+}
+
+func (r *countingResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	r.mu.Lock()
+	r.n++
+	r.mu.Unlock()
+	return r.Resolver.LookupMX(ctx, name)
+}
+
+func (r *countingResolver) lookups() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.n
+}
+
+func TestAPIVerifierFor(t *testing.T) {
+	a := &fakeAPIVerifier{patterns: []string{"*.example.org"}}
+	b := &fakeAPIVerifier{patterns: []string{"mx.example.org", "mx.example.net"}}
+	v := NewVerifier().RegisterAPIVerifier("b", b).RegisterAPIVerifier("a", a)
+
+	assert.Same(t, a, v.apiVerifierFor("mx.example.org."))
+	assert.Same(t, b, v.apiVerifierFor("mx.example.net."))
+	assert.Nil(t, v.apiVerifierFor("mx.example.com."))
+
+	assert.NoError(t, v.EnableAPIVerifier(YAHOO))
+	assert.IsType(t, yahoo{}, v.apiVerifierFor("mta5.am0.yahoodns.net."))
+}
+
+func TestAPIClient(t *testing.T) {
+	v := NewVerifier()
+	assert.Same(t, http.DefaultClient, v.apiClient)
+	assert.Same(t, http.DefaultClient, v.APIClient(nil).apiClient)
+}
@@ -5,10 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"regexp"
-	"strings"
 	"time"
 )
 
@@ -16,22 +16,47 @@ const (
 	signupPage     = "https://login.yahoo.com/account/create?specId=yidregsimplified&lang=en-US&src=&done=https%3A%2F%2Fwww.yahoo.com&display=login"
 	signupEndpoint = "https://login.yahoo.com/account/module/create?validateField=userId"
 	userAgent      = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/136.0.0.0 Safari/537.36"
+
+	// yahooRequestTimeout bounds every request to Yahoo, on top of the deadline of the caller
+	yahooRequestTimeout = 10 * time.Second
 )
 
-// Check yahoo email exists by their login & registration page.
+// YahooAPIConfig configures the Yahoo API verifier, empty URLs are replaced by the ones of Yahoo
+type YahooAPIConfig struct {
+	SignUpPageURL     string        // page of the sign-up form, which sets the session cookies and the sessionIndex
+	SignUpEndpointURL string        // endpoint validating the user ID of the sign-up form
+	SessionTTL        time.Duration // how long the session of the sign-up page is reused at most, defaults to 10 minutes
+}
+
+// yahooMXHostPatterns are the MX hosts of Yahoo, AOL and the domains they host
+var yahooMXHostPatterns = []string{"*.yahoodns.net"}
+
+// NewYahooAPIVerifier creates an API verifier checking Yahoo email exists by their login & registration page,
+// it replaces the built-in one when registered with RegisterAPIVerifier(YAHOO, ...).
 // See https://login.yahoo.com
 // See https://login.yahoo.com/account/create
-func newYahooAPIVerifier(client *http.Client) smtpAPIVerifier {
-	if client == nil {
-		client = http.DefaultClient
+func NewYahooAPIVerifier(config YahooAPIConfig) APIVerifier {
+	if config.SignUpPageURL == "" {
+		config.SignUpPageURL = signupPage
+	}
+	if config.SignUpEndpointURL == "" {
+		config.SignUpEndpointURL = signupEndpoint
 	}
 	return yahoo{
-		client: client,
+		signupPage:     config.SignUpPageURL,
+		signupEndpoint: config.SignUpEndpointURL,
+		sessions:       newYahooSessions(config.SessionTTL),
 	}
 }
 
+// newYahooAPIVerifier creates the Yahoo API verifier enabled by EnableAPIVerifier
+func newYahooAPIVerifier() APIVerifier {
+	return NewYahooAPIVerifier(YahooAPIConfig{})
+}
+
 type yahoo struct {
-	client *http.Client
+	signupPage, signupEndpoint string
+	sessions                   *yahooSessions // session of the sign-up page shared by the checks
 }
 
 type yahooValidateReq struct {
@@ -48,13 +73,45 @@ type errItem struct {
 	Error string `json:"error"`
 }
 
-func (y yahoo) isSupported(host string) bool {
-	// FIXME Is this `contains` too lenient?
-	return strings.Contains(host, "yahoo")
+func (y yahoo) MXHostPatterns() []string {
+	return yahooMXHostPatterns
+}
+
+func (y yahoo) Check(ctx context.Context, client *http.Client, domain, username string) (*SMTP, error) {
+	// Yahoo may reject a cached session before it expires, it is then refreshed and the check retried once
+	for attempt := 0; ; attempt++ {
+		session, err := y.sessions.get(ctx, func(ctx context.Context) (*yahooSession, error) {
+			return y.newSession(ctx, client)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		yahooErrResp, err := y.sendValidateRequest(ctx, client, yahooValidateReq{
+			Domain:       domain,
+			Username:     username,
+			Acrumb:       session.acrumb,
+			SessionIndex: session.sessionIndex,
+			Cookies:      session.cookies,
+		})
+		if errors.Is(err, errYahooSessionRejected) && attempt == 0 {
+			y.sessions.invalidate(session)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		usernameExists := checkUsernameExists(yahooErrResp)
+		return &SMTP{
+			HostExists:  true,
+			Deliverable: usernameExists,
+		}, nil
+	}
 }
 
-func (y yahoo) check(domain, username string) (*SMTP, error) {
-	cookies, signUpPageRespBytes, err := y.toSignUpPage()
+// newSession scrapes the cookies, acrumb and sessionIndex of the sign-up page
+func (y yahoo) newSession(ctx context.Context, client *http.Client) (*yahooSession, error) {
+	cookies, signUpPageRespBytes, err := y.toSignUpPage(ctx, client)
 	if err != nil {
 		return nil, err
 	}
@@ -71,22 +128,7 @@ func (y yahoo) check(domain, username string) (*SMTP, error) {
 	if sessionIndex == "" {
 		return nil, errors.New("yahoo check by api, no sessionIndex")
 	}
-
-	yahooErrResp, err := y.sendValidateRequest(yahooValidateReq{
-		Domain:       domain,
-		Username:     username,
-		Acrumb:       acrumb,
-		SessionIndex: sessionIndex,
-		Cookies:      cookies,
-	})
-	if err != nil {
-		return nil, err
-	}
-	usernameExists := checkUsernameExists(yahooErrResp)
-	return &SMTP{
-		HostExists:  true,
-		Deliverable: usernameExists,
-	}, nil
+	return &yahooSession{cookies: cookies, acrumb: acrumb, sessionIndex: sessionIndex}, nil
 }
 
 var sessionIndexPattern = regexp.MustCompile(`value="([^"]+)" name="sessionIndex"`)
@@ -110,7 +152,7 @@ func checkUsernameExists(resp yahooErrorResp) bool {
 	return false
 }
 
-func (y yahoo) sendValidateRequest(req yahooValidateReq) (yahooErrorResp, error) {
+func (y yahoo) sendValidateRequest(ctx context.Context, client *http.Client, req yahooValidateReq) (yahooErrorResp, error) {
 	var res yahooErrorResp
 	data, err := json.Marshal(struct {
 		Acrumb       string `json:"acrumb"`
@@ -128,9 +170,9 @@ func (y yahoo) sendValidateRequest(req yahooValidateReq) (yahooErrorResp, error)
 	if err != nil {
 		return res, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, yahooRequestTimeout)
 	defer cancel()
-	request, err := http.NewRequestWithContext(ctx, http.MethodPost, signupEndpoint, bytes.NewReader(data))
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, y.signupEndpoint, bytes.NewReader(data))
 	if err != nil {
 		return res, err
 	}
@@ -139,7 +181,7 @@ func (y yahoo) sendValidateRequest(req yahooValidateReq) (yahooErrorResp, error)
 	}
 	request.Header.Add("X-Requested-With", "XMLHttpRequest")
 	request.Header.Add("Content-Type", "application/json; charset=UTF-8")
-	resp, err := y.client.Do(request)
+	resp, err := client.Do(request)
 	if err != nil {
 		return res, err
 	}
@@ -149,23 +191,28 @@ func (y yahoo) sendValidateRequest(req yahooValidateReq) (yahooErrorResp, error)
 		return res, err
 	}
 
+	// an expired or invalid session is answered with a client error or the HTML sign-up page
+	switch resp.StatusCode {
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden:
+		return res, fmt.Errorf("%w: status %d", errYahooSessionRejected, resp.StatusCode)
+	}
 	if !json.Valid(respBytes) {
-		return res, errors.New("yahoo response is not valid JSON")
+		return res, fmt.Errorf("%w: yahoo response is not valid JSON", errYahooSessionRejected)
 	}
 
 	err = json.Unmarshal(respBytes, &res)
 	return res, err
 }
 
-func (y yahoo) toSignUpPage() ([]*http.Cookie, []byte, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (y yahoo) toSignUpPage(ctx context.Context, client *http.Client) ([]*http.Cookie, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, yahooRequestTimeout)
 	defer cancel()
-	request, err := http.NewRequestWithContext(ctx, http.MethodGet, signupPage, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, y.signupPage, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 	request.Header.Add("User-Agent", userAgent)
-	resp, err := y.client.Do(request)
+	resp, err := client.Do(request)
 	if err != nil {
 		return nil, nil, err
 	}
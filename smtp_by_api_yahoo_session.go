@@ -0,0 +1,115 @@
+package emailverifier
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultYahooSessionTTL is how long a session of the sign-up page is reused when its cookies do not expire sooner
+const defaultYahooSessionTTL = 10 * time.Minute
+
+// errYahooSessionRejected is returned when the sign-up endpoint rejects the cookies, acrumb or sessionIndex
+var errYahooSessionRejected = errors.New("yahoo check by api, session rejected")
+
+// yahooSession is the state scraped from the sign-up page, it is never modified once created
+type yahooSession struct {
+	cookies      []*http.Cookie
+	acrumb       string
+	sessionIndex string
+	expiresAt    time.Time
+}
+
+// yahooSessions caches the session of the sign-up page so that every check does not fetch the page again,
+// it is safe for concurrent use
+type yahooSessions struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu       sync.Mutex
+	session  *yahooSession
+	fetching *yahooFetch // the fetch in progress, nil if none
+}
+
+// yahooFetch is a fetch of a session shared by the concurrent callers of get
+type yahooFetch struct {
+	done    chan struct{} // closed once the fetch returned
+	session *yahooSession
+	err     error
+}
+
+// newYahooSessions creates a session cache, sessions are reused for at most ttl
+func newYahooSessions(ttl time.Duration) *yahooSessions {
+	if ttl <= 0 {
+		ttl = defaultYahooSessionTTL
+	}
+	return &yahooSessions{ttl: ttl, now: time.Now}
+}
+
+// get returns the cached session, or creates one with fetch if there is none or it expired.
+// Concurrent callers share a single fetch, which no caller can cancel, and each waits for it until its ctx is done.
+func (s *yahooSessions) get(ctx context.Context, fetch func(context.Context) (*yahooSession, error)) (*yahooSession, error) {
+	s.mu.Lock()
+	if s.session != nil && s.now().Before(s.session.expiresAt) {
+		session := s.session
+		s.mu.Unlock()
+		return session, nil
+	}
+	f := s.fetching
+	if f == nil {
+		f = &yahooFetch{done: make(chan struct{})}
+		s.fetching = f
+		go s.run(context.WithoutCancel(ctx), f, fetch)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.session, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// run calls fetch, bounded by yahooRequestTimeout, caches the session it returns and wakes up the callers of get
+func (s *yahooSessions) run(ctx context.Context, f *yahooFetch, fetch func(context.Context) (*yahooSession, error)) {
+	ctx, cancel := context.WithTimeout(ctx, yahooRequestTimeout)
+	defer cancel()
+	session, err := fetch(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		session.expiresAt = s.expiry(session.cookies)
+		s.session = session
+	}
+	f.session, f.err = session, err
+	s.fetching = nil
+	close(f.done)
+}
+
+// expiry returns when a session with cookies expires: after the ttl, or when the first cookie expires
+func (s *yahooSessions) expiry(cookies []*http.Cookie) time.Time {
+	now := s.now()
+	expiresAt := now.Add(s.ttl)
+	for _, c := range cookies {
+		switch {
+		case c.MaxAge > 0 && now.Add(time.Duration(c.MaxAge)*time.Second).Before(expiresAt):
+			expiresAt = now.Add(time.Duration(c.MaxAge) * time.Second)
+		case c.MaxAge == 0 && !c.Expires.IsZero() && c.Expires.Before(expiresAt):
+			expiresAt = c.Expires
+		}
+	}
+	return expiresAt
+}
+
+// invalidate drops session, if it is still the cached one, so that the next get fetches a new one
+func (s *yahooSessions) invalidate(session *yahooSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.session == session {
+		s.session = nil
+	}
+}
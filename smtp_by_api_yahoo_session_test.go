@@ -0,0 +1,170 @@
+package emailverifier
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYahooCheckByAPI_ReusesSession(t *testing.T) {
+	stub := newYahooStub(t)
+	y := NewYahooAPIVerifier(stub.config())
+
+	for _, username := range []string{"taken", "available", "taken"} {
+		_, err := y.Check(context.Background(), stub.Client(), "yahoo.com", username)
+		require.NoError(t, err)
+	}
+	pages, validates := stub.requests()
+	assert.Equal(t, 1, pages)
+	assert.Equal(t, 3, validates)
+}
+
+func TestYahooCheckByAPI_RefreshesRejectedSession(t *testing.T) {
+	stub := newYahooStub(t)
+	y := NewYahooAPIVerifier(stub.config())
+	_, err := y.Check(context.Background(), stub.Client(), "yahoo.com", "taken")
+	require.NoError(t, err)
+
+	// Yahoo invalidates the crumb before the session expires
+	stub.mu.Lock()
+	stub.crumb = "crumb456"
+	stub.mu.Unlock()
+
+	res, err := y.Check(context.Background(), stub.Client(), "yahoo.com", "taken")
+	require.NoError(t, err)
+	assert.True(t, res.Deliverable)
+	pages, validates := stub.requests()
+	assert.Equal(t, 2, pages)
+	assert.Equal(t, 3, validates)
+}
+
+func TestYahooCheckByAPI_RefreshesExpiredSession(t *testing.T) {
+	stub := newYahooStub(t)
+	y := NewYahooAPIVerifier(YahooAPIConfig{
+		SignUpPageURL:     stub.config().SignUpPageURL,
+		SignUpEndpointURL: stub.config().SignUpEndpointURL,
+		SessionTTL:        time.Minute,
+	}).(yahoo)
+	now := time.Now()
+	y.sessions.now = func() time.Time { return now }
+
+	_, err := y.Check(context.Background(), stub.Client(), "yahoo.com", "taken")
+	require.NoError(t, err)
+	now = now.Add(59 * time.Second)
+	_, err = y.Check(context.Background(), stub.Client(), "yahoo.com", "taken")
+	require.NoError(t, err)
+	pages, _ := stub.requests()
+	assert.Equal(t, 1, pages)
+
+	now = now.Add(time.Second)
+	_, err = y.Check(context.Background(), stub.Client(), "yahoo.com", "taken")
+	require.NoError(t, err)
+	pages, _ = stub.requests()
+	assert.Equal(t, 2, pages)
+}
+
+func TestYahooCheckByAPI_ConcurrentChecks(t *testing.T) {
+	stub := newYahooStub(t)
+	y := NewYahooAPIVerifier(stub.config())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			username := "available"
+			if i%2 == 0 {
+				username = "taken"
+			}
+			res, err := y.Check(context.Background(), stub.Client(), "yahoo.com", username)
+			if assert.NoError(t, err) {
+				assert.Equal(t, i%2 == 0, res.Deliverable)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	pages, validates := stub.requests()
+	assert.Equal(t, 1, pages)
+	assert.Equal(t, 20, validates)
+}
+
+func TestYahooSessions_Expiry(t *testing.T) {
+	s := newYahooSessions(0)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	assert.Equal(t, now.Add(defaultYahooSessionTTL), s.expiry([]*http.Cookie{{Name: "A"}}))
+	assert.Equal(t, now.Add(time.Minute), s.expiry([]*http.Cookie{{Name: "A", MaxAge: 60}}))
+	assert.Equal(t, now.Add(2*time.Minute), s.expiry([]*http.Cookie{{Name: "A", Expires: now.Add(2 * time.Minute)}}))
+	assert.Equal(t, now.Add(defaultYahooSessionTTL), s.expiry([]*http.Cookie{{Name: "A", Expires: now.Add(time.Hour)}}))
+}
+
+func TestYahooSessions_Invalidate(t *testing.T) {
+	s := newYahooSessions(time.Hour)
+	fetches := 0
+	fetch := func(context.Context) (*yahooSession, error) {
+		fetches++
+		return &yahooSession{acrumb: "crumb"}, nil
+	}
+
+	first, err := s.get(context.Background(), fetch)
+	require.NoError(t, err)
+	second, err := s.get(context.Background(), fetch)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+
+	s.invalidate(first)
+	third, err := s.get(context.Background(), fetch)
+	require.NoError(t, err)
+	assert.NotSame(t, first, third)
+	assert.Equal(t, 2, fetches)
+
+	// a stale session does not drop its replacement
+	s.invalidate(first)
+	fourth, _ := s.get(context.Background(), fetch)
+	assert.Same(t, third, fourth)
+
+	// the cached session is returned without fetching
+	_, err = s.get(context.Background(), func(context.Context) (*yahooSession, error) {
+		return nil, errors.New("unreachable")
+	})
+	assert.NoError(t, err)
+}
+
+func TestYahooSessions_WaiterDeadline(t *testing.T) {
+	s := newYahooSessions(time.Hour)
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (*yahooSession, error) {
+		<-release
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return &yahooSession{acrumb: "crumb"}, nil
+	}
+
+	// the caller gives up waiting, the fetch goes on
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := s.get(ctx, fetch)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// a later caller waits for the same fetch, which was not canceled with the first caller
+	got := make(chan *yahooSession)
+	go func() {
+		session, _ := s.get(context.Background(), func(context.Context) (*yahooSession, error) {
+			return nil, errors.New("not shared")
+		})
+		got <- session
+	}()
+	close(release)
+	if session := <-got; assert.NotNil(t, session) {
+		assert.Equal(t, "crumb", session.acrumb)
+	}
+}
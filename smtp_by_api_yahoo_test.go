@@ -1,7 +1,13 @@
 package emailverifier
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,15 +15,15 @@ import (
 )
 
 func TestYahooCheckByAPI(t *testing.T) {
-	yahooAPIVerifier := newYahooAPIVerifier(nil)
+	yahooAPIVerifier := newYahooAPIVerifier()
 	t.Run("email exists", func(tt *testing.T) {
-		res, err := yahooAPIVerifier.check("yahoo.com", "hello")
+		res, err := yahooAPIVerifier.Check(context.Background(), http.DefaultClient, "yahoo.com", "hello")
 		require.NoError(t, err)
 		assert.Equal(t, true, res.HostExists)
 		assert.Equal(t, true, res.Deliverable)
 	})
 	t.Run("invalid email not exists", func(tt *testing.T) {
-		res, err := yahooAPIVerifier.check("yahoo.com", "123")
+		res, err := yahooAPIVerifier.Check(context.Background(), http.DefaultClient, "yahoo.com", "123")
 		require.NoError(t, err)
 		assert.Equal(t, true, res.HostExists)
 		assert.Equal(t, false, res.Deliverable)
@@ -45,3 +51,118 @@ func TestGetAcrumb(t *testing.T) {
 	acrumb = getAcrumb(cookies2)
 	assert.Empty(t, acrumb)
 }
+
+// yahooStub serves the sign-up page and endpoint of Yahoo, "taken" is the only existing user ID
+type yahooStub struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	crumb     string // acrumb of the sessions accepted by the endpoint
+	noCookies bool   // whether the sign-up page sets no cookie
+	pages     int    // number of sign-up page requests
+	validates int    // number of endpoint requests
+}
+
+func newYahooStub(t *testing.T) *yahooStub {
+	stub := &yahooStub{crumb: "crumb123"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/account/create", func(w http.ResponseWriter, r *http.Request) {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		stub.pages++
+		if !stub.noCookies {
+			http.SetCookie(w, &http.Cookie{Name: "AS", Value: "v=1&s=" + stub.crumb + "&d=A6454c24b"})
+		}
+		_, _ = io.WriteString(w, `<form><input type="hidden" value="session-1" name="sessionIndex"></form>`)
+	})
+	mux.HandleFunc("/account/module/create", func(w http.ResponseWriter, r *http.Request) {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		stub.validates++
+
+		var req struct {
+			Acrumb       string `json:"acrumb"`
+			UserID       string `json:"userId"`
+			SessionIndex string `json:"sessionIndex"`
+			YidDomain    string `json:"yidDomain"`
+		}
+		cookie, err := r.Cookie("AS")
+		if r.Method != http.MethodPost || err != nil || json.NewDecoder(r.Body).Decode(&req) != nil ||
+			req.Acrumb != stub.crumb || req.SessionIndex != "session-1" || !strings.Contains(cookie.Value, "s="+stub.crumb) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"errors":[{"name":"acrumb","error":"INVALID"}]}`)
+			return
+		}
+		if req.UserID == "taken" {
+			_, _ = io.WriteString(w, `{"errors":[{"name":"userId","error":"IDENTIFIER_EXISTS"}]}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"errors":[]}`)
+	})
+	stub.Server = httptest.NewServer(mux)
+	t.Cleanup(stub.Close)
+	return stub
+}
+
+// requests returns the number of sign-up page and endpoint requests
+func (s *yahooStub) requests() (pages, validates int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pages, s.validates
+}
+
+// config returns the config of a Yahoo API verifier using the stub
+func (s *yahooStub) config() YahooAPIConfig {
+	return YahooAPIConfig{
+		SignUpPageURL:     s.URL + "/account/create?specId=yidregsimplified",
+		SignUpEndpointURL: s.URL + "/account/module/create?validateField=userId",
+	}
+}
+
+func TestYahooCheckByAPI_Stub(t *testing.T) {
+	stub := newYahooStub(t)
+	yahooAPIVerifier := NewYahooAPIVerifier(stub.config())
+
+	res, err := yahooAPIVerifier.Check(context.Background(), stub.Client(), "yahoo.com", "taken")
+	require.NoError(t, err)
+	assert.Equal(t, &SMTP{HostExists: true, Deliverable: true}, res)
+
+	res, err = yahooAPIVerifier.Check(context.Background(), stub.Client(), "yahoo.com", "available")
+	require.NoError(t, err)
+	assert.Equal(t, &SMTP{HostExists: true, Deliverable: false}, res)
+
+	stub.mu.Lock()
+	stub.noCookies = true
+	stub.mu.Unlock()
+	_, err = NewYahooAPIVerifier(stub.config()).Check(context.Background(), stub.Client(), "yahoo.com", "taken")
+	assert.EqualError(t, err, "yahoo check by api, no cookies")
+}
+
+func TestYahooCheckByAPI_Context(t *testing.T) {
+	stub := newYahooStub(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewYahooAPIVerifier(stub.config()).Check(ctx, stub.Client(), "yahoo.com", "taken")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestYahooCheckByAPI_Verifier(t *testing.T) {
+	stub := newYahooStub(t)
+	v := NewVerifier().
+		EnableSMTPCheck().
+		UseResolver(stubResolver{"yahoo.com": {{Host: "mta5.am0.yahoodns.net.", Pref: 1}}}).
+		APIClient(stub.Client()).
+		RegisterAPIVerifier(YAHOO, NewYahooAPIVerifier(stub.config()))
+
+	res, err := v.CheckSMTP(context.Background(), "yahoo.com", "taken")
+	require.NoError(t, err)
+	assert.True(t, res.Deliverable)
+}
+
+func TestNewYahooAPIVerifier_Defaults(t *testing.T) {
+	y := newYahooAPIVerifier().(yahoo)
+	assert.Equal(t, signupPage, y.signupPage)
+	assert.Equal(t, signupEndpoint, y.signupEndpoint)
+	assert.Equal(t, defaultYahooSessionTTL, y.sessions.ttl)
+}
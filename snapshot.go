@@ -0,0 +1,180 @@
+package emailverifier
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// snapshotLists are the lists which are persisted in the snapshot directory
+var snapshotLists = []string{ListDisposable, ListFree, ListRole, ListTLD}
+
+// SnapshotStatus reports the state of the snapshot directory of a Verifier
+type SnapshotStatus struct {
+	Dir     string // the snapshot directory, empty when snapshots are disabled
+	LoadErr error  // why snapshots could not be loaded from Dir, nil if they were loaded or there were none
+}
+
+// SnapshotInfo describes the on-disk snapshot of a list
+type SnapshotInfo struct {
+	List      string    `json:"list"`       // name of the list
+	Source    string    `json:"source"`     // URL the list was fetched from
+	FetchedAt time.Time `json:"fetched_at"` // when the list was fetched from its source
+	Entries   int       `json:"entries"`    // number of entries in the snapshot
+}
+
+// Age returns how long ago the list of the snapshot was fetched
+func (s SnapshotInfo) Age() time.Duration {
+	return time.Since(s.FetchedAt)
+}
+
+// snapshot is the on-disk format of a list fetched from its source
+type snapshot struct {
+	SnapshotInfo
+	ETag         string   `json:"etag,omitempty"`
+	LastModified string   `json:"last_modified,omitempty"`
+	Items        []string `json:"items"`
+}
+
+// snapshotStore persists lists fetched by the updater so that they survive restarts.
+// It is safe for concurrent use.
+type snapshotStore struct {
+	dir string
+
+	mu        sync.RWMutex
+	snapshots map[string]snapshot // latest snapshot of every list, without items
+}
+
+// newSnapshotStore creates a store persisting snapshots in dir, the directory is created if needed
+func newSnapshotStore(dir string) (*snapshotStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &snapshotStore{dir: dir, snapshots: map[string]snapshot{}}, nil
+}
+
+// path returns the path of the snapshot file of the list
+func (s *snapshotStore) path(list string) string {
+	return filepath.Join(s.dir, list+".json")
+}
+
+// load reads the snapshot of the list, ok is false when there is no snapshot
+func (s *snapshotStore) load(list string) (snap snapshot, ok bool, err error) {
+	content, err := os.ReadFile(s.path(list))
+	if errors.Is(err, os.ErrNotExist) {
+		return snap, false, nil
+	}
+	if err != nil {
+		return snap, false, err
+	}
+	if err = json.Unmarshal(content, &snap); err != nil {
+		return snap, false, fmt.Errorf("read snapshot %s: %w", s.path(list), err)
+	}
+	return snap, true, nil
+}
+
+// save writes the snapshot atomically, readers never see a partially written file
+func (s *snapshotStore) save(snap snapshot) error {
+	content, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.dir, snap.List+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), s.path(snap.List)); err != nil {
+		return err
+	}
+
+	s.remember(snap)
+	return nil
+}
+
+// remember keeps the latest snapshot of the list without its items
+func (s *snapshotStore) remember(snap snapshot) {
+	snap.Items = nil
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots[snap.List] = snap
+}
+
+// latest returns the latest snapshot of the list without its items
+func (s *snapshotStore) latest(list string) (snapshot, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap, ok := s.snapshots[list]
+	return snap, ok
+}
+
+// SnapshotDir enables persisting the lists fetched by automatic updates to dir,
+// and loads the snapshots previously written to dir into the lists of this verifier.
+// A restarted service calling it starts with the last fetched lists instead of the embedded ones,
+// even if the sources cannot be reached.
+// Snapshots which cannot be loaded, or which shrink a list like a refused update, are reported by SnapshotStatus,
+// the lists keep their entries then.
+func (v *Verifier) SnapshotDir(dir string) *Verifier {
+	v.snapshotStatus = SnapshotStatus{Dir: dir}
+	store, err := newSnapshotStore(dir)
+	if err != nil {
+		v.snapshotStatus.LoadErr = err
+		return v
+	}
+
+	var errs []error
+	for _, name := range snapshotLists {
+		snap, ok, err := store.load(name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		l, _, _ := v.lookupList(name)
+		if size := l.size(); float64(len(snap.Items)) < float64(size)*minUpdateRatio {
+			errs = append(errs, fmt.Errorf("refuse to load %s list from snapshot %s: got %d entries, currently %d",
+				name, store.path(name), len(snap.Items), size))
+			continue
+		}
+		l.update(snap.Items, snap.Source, snap.FetchedAt)
+		store.remember(snap)
+	}
+
+	v.snapshots = store
+	v.snapshotStatus.LoadErr = errors.Join(errs...)
+	return v
+}
+
+// SnapshotStatus returns the snapshot directory and the error of loading its snapshots
+func (v *Verifier) SnapshotStatus() SnapshotStatus {
+	return v.snapshotStatus
+}
+
+// Snapshot returns the snapshot of the list loaded from or last written to the snapshot directory,
+// ok is false when there is none
+func (v *Verifier) Snapshot(list string) (info SnapshotInfo, ok bool) {
+	if v.snapshots == nil {
+		return info, false
+	}
+	snap, ok := v.snapshots.latest(list)
+	return snap.SnapshotInfo, ok
+}
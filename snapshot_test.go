@@ -0,0 +1,130 @@
+package emailverifier
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestSnapshotDir_WarmRestart(t *testing.T) {
+	const source = "https://lists.example.com/role.txt"
+	dir := t.TempDir()
+
+	defer gock.Off()
+	gock.New("https://lists.example.com").
+		Get("/role.txt").
+		Reply(http.StatusOK).
+		SetHeader("ETag", `"v1"`).
+		BodyString("admin\nbilling-team\n")
+
+	v := NewVerifier().ReplaceRoleAccounts([]string{"admin"}).SnapshotDir(dir)
+	require.NoError(t, v.SnapshotStatus().LoadErr)
+	u := newUpdater(v, AutoUpdateConfig{Sources: map[string]string{ListRole: source}})
+	require.NoError(t, u.run(context.Background()))
+	assert.FileExists(t, filepath.Join(dir, "role.json"))
+
+	// a restarted verifier starts with the snapshot, even if the source cannot be reached
+	restarted := NewVerifier().ReplaceRoleAccounts([]string{"admin"}).SnapshotDir(dir)
+	assert.Equal(t, SnapshotStatus{Dir: dir}, restarted.SnapshotStatus())
+	assert.True(t, restarted.IsRoleAccount("billing-team"))
+
+	info, ok := restarted.Snapshot(ListRole)
+	require.True(t, ok)
+	assert.Equal(t, ListRole, info.List)
+	assert.Equal(t, source, info.Source)
+	assert.Equal(t, 2, info.Entries)
+	assert.True(t, info.Age() >= 0 && info.Age() < time.Minute)
+
+	// and continues with conditional requests
+	gock.New("https://lists.example.com").
+		Get("/role.txt").
+		MatchHeader("If-None-Match", `"v1"`).
+		Reply(http.StatusNotModified)
+	u = newUpdater(restarted, AutoUpdateConfig{Sources: map[string]string{ListRole: source}})
+	event := u.update(context.Background(), u.sources[0])
+	assert.NoError(t, event.Err)
+	assert.False(t, event.Modified)
+	assert.True(t, gock.IsDone())
+}
+
+func TestSnapshotDir_Empty(t *testing.T) {
+	v := NewVerifier().SnapshotDir(filepath.Join(t.TempDir(), "snapshots"))
+	require.NoError(t, v.SnapshotStatus().LoadErr)
+
+	_, ok := v.Snapshot(ListDisposable)
+	assert.False(t, ok)
+	assert.True(t, v.IsDisposable("dbbd8.club"))
+}
+
+func TestSnapshotDir_Corrupted(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "free.json"), []byte("{"), 0o600))
+
+	v := NewVerifier().SnapshotDir(dir)
+	assert.Equal(t, dir, v.SnapshotStatus().Dir)
+	assert.ErrorContains(t, v.SnapshotStatus().LoadErr, "free.json")
+	assert.True(t, v.IsFreeDomain("gmail.com"))
+}
+
+func TestSnapshotDir_Shrunk(t *testing.T) {
+	dir := t.TempDir()
+	store, err := newSnapshotStore(dir)
+	require.NoError(t, err)
+	require.NoError(t, store.save(snapshot{
+		SnapshotInfo: SnapshotInfo{List: ListDisposable, Source: disposableDataURL, FetchedAt: time.Now(), Entries: 1},
+		ETag:         `"v1"`,
+		Items:        []string{"a.org"},
+	}))
+
+	// the snapshot is refused like an update shrinking the list, its validators are not sent back
+	v := NewVerifier().SnapshotDir(dir)
+	assert.ErrorContains(t, v.SnapshotStatus().LoadErr, "refuse to load disposable list")
+	assert.True(t, v.IsDisposable("dbbd8.club"))
+	assert.False(t, v.IsDisposable("a.org"))
+	_, ok := v.Snapshot(ListDisposable)
+	assert.False(t, ok)
+}
+
+func TestSnapshotDir_NotADirectory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+
+	v := NewVerifier().SnapshotDir(path)
+	assert.Error(t, v.SnapshotStatus().LoadErr)
+	_, ok := v.Snapshot(ListFree)
+	assert.False(t, ok)
+	assert.NoError(t, v.Err())
+}
+
+func TestSnapshot_Disabled(t *testing.T) {
+	v := NewVerifier()
+	_, ok := v.Snapshot(ListDisposable)
+	assert.False(t, ok)
+	assert.Equal(t, SnapshotStatus{}, v.SnapshotStatus())
+}
+
+func TestSnapshotStore_SaveAtomically(t *testing.T) {
+	dir := t.TempDir()
+	store, err := newSnapshotStore(dir)
+	require.NoError(t, err)
+
+	snap := snapshot{SnapshotInfo: SnapshotInfo{List: ListFree, Entries: 1}, Items: []string{"a.example"}}
+	require.NoError(t, store.save(snap))
+	require.NoError(t, store.save(snap))
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	loaded, ok, err := store.load(ListFree)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a.example"}, loaded.Items)
+}
@@ -0,0 +1,44 @@
+package emailverifier
+
+import "strings"
+
+// specialUseDomains are the special-use domain names which never receive email on the public Internet,
+// mostly from the IANA registry, see https://www.iana.org/assignments/special-use-domain-names
+var specialUseDomains = map[string]string{
+	"example":     "RFC 2606",
+	"example.com": "RFC 2606",
+	"example.net": "RFC 2606",
+	"example.org": "RFC 2606",
+	"invalid":     "RFC 2606",
+	"test":        "RFC 2606",
+	"localhost":   "RFC 6761",
+	"local":       "RFC 6762",
+	"onion":       "RFC 7686",
+	"home.arpa":   "RFC 8375",
+	"alt":         "RFC 9476",
+	"internal":    "ICANN private-use TLD",
+}
+
+// SpecialUseDomain returns the special-use name (RFC 2606, 6761, 6762 and later) domain belongs to,
+// e.g. "test" for "shop.test" or "example.com" for "www.example.com".
+// ok is false when domain is an ordinary domain name.
+func SpecialUseDomain(domain string) (name string, ok bool) {
+	domain = strings.TrimSuffix(domainToASCII(strings.ToLower(strings.TrimSpace(domain))), ".")
+	for domain != "" {
+		if _, ok = specialUseDomains[domain]; ok {
+			return domain, true
+		}
+		i := strings.IndexByte(domain, '.')
+		if i == -1 {
+			break
+		}
+		domain = domain[i+1:]
+	}
+	return "", false
+}
+
+// IsSpecialUseDomain checks if domain is a special-use domain, such as example.com or a .test, .local or .onion domain
+func IsSpecialUseDomain(domain string) bool {
+	_, ok := SpecialUseDomain(domain)
+	return ok
+}
@@ -0,0 +1,55 @@
+package emailverifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecialUseDomain(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string
+		ok     bool
+	}{
+		{domain: "example.com", want: "example.com", ok: true},
+		{domain: "www.Example.ORG.", want: "example.org", ok: true},
+		{domain: "shop.test", want: "test", ok: true},
+		{domain: "foo.invalid", want: "invalid", ok: true},
+		{domain: "app.localhost", want: "localhost", ok: true},
+		{domain: "printer.local", want: "local", ok: true},
+		{domain: "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion", want: "onion", ok: true},
+		{domain: "corp.internal", want: "internal", ok: true},
+		{domain: "router.home.arpa", want: "home.arpa", ok: true},
+		{domain: "name.alt", want: "alt", ok: true},
+		{domain: "gmail.com"},
+		{domain: "example.co"},
+		{domain: "notexample.com"},
+		{domain: "in-addr.arpa"},
+		{domain: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			got, ok := SpecialUseDomain(tt.domain)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.ok, IsSpecialUseDomain(tt.domain))
+		})
+	}
+}
+
+func TestVerify_SpecialUseDomain(t *testing.T) {
+	// SMTP is enabled, the test would hang or fail if the domain was looked up
+	v := NewVerifier().EnableSMTPCheck()
+
+	for _, email := range []string{"user@example.com", "user@shop.test", "user@printer.local"} {
+		ret, err := v.Verify(context.Background(), email)
+		require.NoError(t, err)
+		assert.True(t, ret.SpecialUse, email)
+		assert.Equal(t, reachableNo, ret.Reachable, email)
+		assert.False(t, ret.TLDExists, email)
+		assert.Nil(t, ret.SMTP, email)
+	}
+}
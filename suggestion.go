@@ -1,84 +1,406 @@
 package emailverifier
 
 import (
+	"context"
+	"sort"
 	"strings"
+	"sync"
+	"unicode/utf8"
 
-	"github.com/hbollon/go-edlib"
+	"golang.org/x/sync/errgroup"
 )
 
+// Rules producing domain suggestions
+const (
+	SuggestionRuleDomain    = "domain"  // the whole domain is similar to a known domain
+	SuggestionRuleSLD       = "sld"     // the second level domain is similar to a known one, e.g. homail.com
+	SuggestionRuleTLD       = "tld"     // the top level domain is similar to a known one, e.g. gmail.con
+	SuggestionRuleSLDAndTLD = "sld+tld" // both the second and top level domains are corrected
+)
+
+// corpora of the domains suggested by default
+var (
+	freeDomainCorpus        = newSuggestionCorpus(freeDomains)
+	secondLevelDomainCorpus = newSuggestionCorpus(suggestionSecondLevelDomains)
+	topLevelDomainCorpus    = newSuggestionCorpus(suggestionTopLevelDomains)
+)
+
+// publicSuffixCorpora returns the corpora of the generic and country code TLDs and of the ICANN public suffixes,
+// by number of dots. Top level domains are corrected to them when no common one is similar.
+var publicSuffixCorpora = sync.OnceValue(func() map[int]*suggestionCorpus {
+	suffixes := make(map[int]map[string]bool)
+	add := func(suffix string) {
+		// users type the Unicode form of internationalized suffixes, which the typo models do not handle
+		if strings.Contains(suffix, "xn--") || strings.ContainsAny(suffix, "*!") || !isASCII(suffix) {
+			return
+		}
+		dots := strings.Count(suffix, ".")
+		if suffixes[dots] == nil {
+			suffixes[dots] = make(map[string]bool)
+		}
+		suffixes[dots][suffix] = true
+	}
+	for tld := range GenericTLDs {
+		add(tld)
+	}
+	for tld := range CountryCodeTLDs {
+		add(tld)
+	}
+	for rule := range publicSuffixRules {
+		add(rule)
+	}
+
+	corpora := make(map[int]*suggestionCorpus, len(suffixes))
+	for dots, domains := range suffixes {
+		corpora[dots] = newSuggestionCorpus(domains)
+	}
+	return corpora
+})
+
+// DomainSuggestion is a candidate correction of a misspelled domain
+type DomainSuggestion struct {
+	Domain string  `json:"domain"` // suggested domain
+	Score  float32 `json:"score"`  // similarity between the domain and the suggestion, from 0 to 1
+	Rule   string  `json:"rule"`   // rule which produced the suggestion, e.g. SuggestionRuleDomain
+}
+
+// SuggestionConfig configures domain suggestions, zero values are replaced by the defaults
+type SuggestionConfig struct {
+	DomainThreshold      float32 // minimum similarity of a whole domain, defaults to 0.82
+	SecondLevelThreshold float32 // minimum similarity of a second level domain, defaults to 0.82
+	TopLevelThreshold    float32 // minimum similarity of a top level domain, defaults to 0.6
+	MaxSuggestions       int     // maximum number of suggestions returned by SuggestDomains, defaults to 3
+	PopularityWeight     float32 // how much the popularity of a registered domain adds to its similarity, defaults to 0.15
+
+	// RequireMX only suggests the domains having MX records, looked up with the resolver of the verifier,
+	// see UseResolver
+	RequireMX bool
+
+	// KeyboardLayout enables the keyboard aware typo model: hitting an adjacent key, swapping two letters,
+	// doubling a letter or missing one of a double letter count as half an edit, and popular domains are preferred.
+	// Suggestions are based on the plain Levenshtein distance when empty.
+	KeyboardLayout KeyboardLayout
+}
+
+// withDefaults returns the config with zero values replaced by the defaults
+func (c SuggestionConfig) withDefaults() SuggestionConfig {
+	if c.DomainThreshold <= 0 {
+		c.DomainThreshold = domainThreshold
+	}
+	if c.SecondLevelThreshold <= 0 {
+		c.SecondLevelThreshold = secondLevelThreshold
+	}
+	if c.TopLevelThreshold <= 0 {
+		c.TopLevelThreshold = topLevelThreshold
+	}
+	if c.MaxSuggestions <= 0 {
+		c.MaxSuggestions = defaultMaxSuggestions
+	}
+	if c.PopularityWeight <= 0 {
+		c.PopularityWeight = defaultPopularityWeight
+	}
+	return c
+}
+
 // SuggestDomain checks if domain has a typo and suggests a similar correct domain from metadata,
 // returns a suggestion
 func (v *Verifier) SuggestDomain(domain string) string {
-	if domain == "" {
+	return v.SuggestDomainContext(context.Background(), domain)
+}
+
+// SuggestDomainContext is like SuggestDomain, the MX lookups of SuggestionConfig.RequireMX are bounded by ctx
+func (v *Verifier) SuggestDomainContext(ctx context.Context, domain string) string {
+	suggestions := v.suggestDomains(ctx, domain, 1)
+	if len(suggestions) == 0 {
 		return ""
 	}
+	return suggestions[0].Domain
+}
 
+// SuggestDomains checks if domain has a typo and returns up to MaxSuggestions candidate corrections,
+// best first. Suggestions of the whole domain come before the ones correcting its second or top level domain.
+func (v *Verifier) SuggestDomains(domain string) []DomainSuggestion {
+	return v.SuggestDomainsContext(context.Background(), domain)
+}
+
+// SuggestDomainsContext is like SuggestDomains, the MX lookups of SuggestionConfig.RequireMX are bounded by ctx
+func (v *Verifier) SuggestDomainsContext(ctx context.Context, domain string) []DomainSuggestion {
+	return v.suggestDomains(ctx, domain, v.suggestionConfig.MaxSuggestions)
+}
+
+// suggest sets the domain suggestions of the result and the address with the best one
+func (v *Verifier) suggest(ctx context.Context, ret *Result, domain string) {
+	ret.Suggestions = v.suggestDomains(ctx, domain, v.suggestionConfig.MaxSuggestions)
+	ret.Suggestion = ""
+	if len(ret.Suggestions) > 0 {
+		ret.Suggestion = ret.Suggestions[0].Domain
+		ret.AddressSuggestion = ret.Email[:strings.LastIndex(ret.Email, "@")+1] + ret.Suggestion
+	}
+}
+
+// suggestDomains returns up to n candidate corrections of domain
+func (v *Verifier) suggestDomains(ctx context.Context, domain string, n int) []DomainSuggestion {
+	if domain == "" {
+		return nil
+	}
+
+	config := v.suggestionConfig
+	corpora := v.levelCorpora()
 	domain = strings.ToLower(domain)
 	sld, tld := splitDomain(domain)
 	// If the domain is a valid second level domain and top level domain, do not suggest anything
 	if sld != "" && tld != "" {
-		if suggestionSecondLevelDomains[sld] && suggestionTopLevelDomains[tld] {
-			return ""
+		if corpora.secondLevel.domains[sld] && corpora.topLevel.domains[tld] {
+			return nil
 		}
 	}
 
-	closestDomain := findClosestDomain(domain, freeDomains, domainThreshold)
-	if closestDomain != "" {
-		if closestDomain == domain {
-			// The domain exactly matches one of the suggestion domains, no suggestion provided.
-			return ""
+	// every rule scores its candidates with the same model, so that they are ranked against each other
+	model := typoModelFor(config.KeyboardLayout).withTranspositions()
+	corpus := v.domainCorpus()
+	domains, exact := findSimilarDomains(domain, corpus, config.DomainThreshold, model, config.PopularityWeight)
+	if exact {
+		// The domain exactly matches one of the suggestion domains, no suggestion provided.
+		return nil
+	}
+	candidates := make([]rankedSuggestion, 0, len(domains))
+	for _, d := range domains {
+		candidates = append(candidates, rankedSuggestion{
+			DomainSuggestion: DomainSuggestion{Domain: d.value, Score: d.score, Rule: SuggestionRuleDomain},
+			rank:             d.rank,
+		})
+	}
+
+	// letters are often swapped in short second and top level domains, such as "ocm"
+	for _, split := range levelSplits(domain, sld, tld) {
+		for _, s := range suggestLevels(domain, split[0], split[1], corpora, config, model) {
+			candidates = append(candidates, rankedSuggestion{
+				DomainSuggestion: s,
+				rank:             corpus.rank(s.Domain, s.Score, model, config.PopularityWeight),
+			})
 		}
-		// The domain closely matches one of the suggestion domains
-		return closestDomain
 	}
+	suggestions := sortRanked(candidates)
 
-	var localTypo bool
-	closestDomain = domain
+	if config.RequireMX {
+		suggestions = v.withMX(ctx, limitSuggestions(suggestions, len(suggestions)), n)
+	}
+	return limitSuggestions(suggestions, n)
+}
 
-	closestSecondLevelDomain := findClosestDomain(sld, suggestionSecondLevelDomains, secondLevelThreshold)
-	closestTopLevelDomain := findClosestDomain(tld, suggestionTopLevelDomains, topLevelThreshold)
+// levelSplits returns the second and top level domains of domain to correct: the ones of its public suffix
+// and, when the suffix has a single label, a two labels suffix with a short second level such as "co.ku",
+// which may be a misspelled "co.uk"
+func levelSplits(domain, sld, tld string) [][2]string {
+	splits := [][2]string{{sld, tld}}
+	if strings.Contains(tld, ".") || len(sld) > 3 {
+		return splits
+	}
+	labels := strings.Split(strings.TrimSuffix(domain, "."), ".")
+	if n := len(labels); n >= 3 {
+		splits = append(splits, [2]string{labels[n-3], labels[n-2] + "." + labels[n-1]})
+	}
+	return splits
+}
 
-	if closestSecondLevelDomain != "" && closestSecondLevelDomain != sld {
-		localTypo = true
-		closestDomain = strings.Replace(closestDomain, sld, closestSecondLevelDomain, -1)
+// withMX returns the first n suggestions whose domain has MX records,
+// the domains are looked up concurrently and every lookup is bounded by suggestionMXTimeout
+func (v *Verifier) withMX(ctx context.Context, suggestions []DomainSuggestion, n int) []DomainSuggestion {
+	hasMX := make([]bool, len(suggestions))
+	var g errgroup.Group
+	g.SetLimit(maxSuggestionMXLookups)
+	for i, s := range suggestions {
+		g.Go(func() error {
+			domain, err := toASCII(s.Domain)
+			if err != nil {
+				return nil
+			}
+			ctx, cancel := context.WithTimeout(ctx, suggestionMXTimeout)
+			defer cancel()
+			mx, err := v.resolver.LookupMX(ctx, domain)
+			hasMX[i] = err == nil && len(mx) > 0
+			return nil
+		})
+	}
+	_ = g.Wait()
 
+	ret := suggestions[:0]
+	for i, s := range suggestions {
+		if hasMX[i] && len(ret) < n {
+			ret = append(ret, s)
+		}
 	}
-	if closestTopLevelDomain != "" && closestTopLevelDomain != tld && sld != "" {
-		localTypo = true
-		closestDomain = strings.Replace(closestDomain, tld, closestTopLevelDomain, -1)
+	return ret
+}
+
+// suggestLevels suggests corrections of the second and top level domains of domain,
+// every candidate applies all the corrections found
+func suggestLevels(domain, sld, tld string, corpora levelCorpora, config SuggestionConfig, model *typoModel) []DomainSuggestion {
+	if sld == "" {
+		return nil
+	}
+
+	slds, _ := findSimilarDomains(sld, corpora.secondLevel, config.SecondLevelThreshold, model, config.PopularityWeight)
+	slds = withoutValue(slds, sld)
+	tlds := findSimilarSuffixes(tld, corpora.topLevel, config, model)
+	if len(slds) == 0 && len(tlds) == 0 {
+		return nil
 	}
 
-	if localTypo {
-		return closestDomain
+	rule := SuggestionRuleSLDAndTLD
+	switch {
+	case len(tlds) == 0:
+		rule = SuggestionRuleSLD
+		tlds = []similarity{{value: tld}}
+	case len(slds) == 0:
+		rule = SuggestionRuleTLD
+		slds = []similarity{{value: sld}}
 	}
 
-	return ""
+	prefix := strings.TrimSuffix(domain, sld+"."+tld)
+	var suggestions []DomainSuggestion
+	for _, s := range slds {
+		for _, t := range tlds {
+			candidate := prefix + s.value + "." + t.value
+			score := model.similarity(domain, candidate)
+			suggestions = append(suggestions, DomainSuggestion{Domain: candidate, Score: score, Rule: rule})
+		}
+	}
+	return suggestions
 }
 
-// findClosestDomain finds the string most similar to the domain via Levenshtein algorithms.
-func findClosestDomain(domain string, domains map[string]bool, threshold float32) string {
-	maxDist := float32(-1)
-	var closestDomain string
+// findSimilarSuffixes finds the common top level domains of topLevel similar to suffix, or when there is none
+// and suffix does not exist, the TLDs and public suffixes similar to it having as many labels.
+// Existing suffixes of several labels are not corrected.
+func findSimilarSuffixes(suffix string, topLevel *suggestionCorpus, config SuggestionConfig, model *typoModel) []similarity {
+	if strings.Contains(suffix, ".") && isPublicSuffix(suffix) {
+		// domains are registered under every public suffix such as com.br, not only the common ones
+		return nil
+	}
+	similar, _ := findSimilarDomains(suffix, topLevel, config.TopLevelThreshold, model, config.PopularityWeight)
+	similar = withoutValue(similar, suffix)
+	if len(similar) > 0 || isPublicSuffix(suffix) {
+		return similar
+	}
 
-	if domain == "" || len(domains) == 0 {
-		return closestDomain
+	corpus, ok := publicSuffixCorpora()[strings.Count(suffix, ".")]
+	if !ok {
+		return nil
 	}
+	similar, _ = findSimilarDomains(suffix, corpus, config.TopLevelThreshold, model, config.PopularityWeight)
+	return similar
+}
 
-	for d := range domains {
-		if domain == d {
-			return domain
+// isPublicSuffix reports whether suffix is a delegated TLD or an ICANN public suffix
+func isPublicSuffix(suffix string) bool {
+	_, ok := tldInfos[domainToASCII(suffix)]
+	return ok || publicSuffixRules[suffix]
+}
+
+// limitSuggestions removes duplicated domains, keeping the first one, and returns at most n suggestions
+func limitSuggestions(suggestions []DomainSuggestion, n int) []DomainSuggestion {
+	seen := make(map[string]bool, len(suggestions))
+	ret := suggestions[:0]
+	for _, s := range suggestions {
+		if seen[s.Domain] || len(ret) == n {
+			continue
 		}
+		seen[s.Domain] = true
+		ret = append(ret, s)
+	}
+	if len(ret) == 0 {
+		return nil
+	}
+	return ret
+}
 
-		dist, _ := edlib.StringsSimilarity(domain, d, edlib.Levenshtein)
-		if dist > maxDist {
-			maxDist = dist
-			closestDomain = d
+// rankedSuggestion is a suggestion along with the value suggestions are sorted by, see suggestionCorpus.rank
+type rankedSuggestion struct {
+	DomainSuggestion
+	rank float32
+}
+
+// sortRanked returns the suggestions by descending rank, see preferDomain for ties
+func sortRanked(candidates []rankedSuggestion) []DomainSuggestion {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].rank != candidates[j].rank {
+			return candidates[i].rank > candidates[j].rank
 		}
+		return preferDomain(candidates[i].Domain, candidates[j].Domain)
+	})
+	suggestions := make([]DomainSuggestion, len(candidates))
+	for i, c := range candidates {
+		suggestions[i] = c.DomainSuggestion
 	}
+	return suggestions
+}
 
-	if maxDist >= threshold && closestDomain != "" {
-		return closestDomain
+// preferDomain breaks the tie between two equally ranked domains: the most popular one, such as
+// hotmail.com rather than hotmail.co, else the first in alphabetical order
+func preferDomain(a, b string) bool {
+	if pa, pb := popularDomains[a], popularDomains[b]; pa != pb {
+		return pa > pb
 	}
+	return a < b
+}
+
+// similarity is a candidate value along with its similarity to the looked up one
+type similarity struct {
+	value string
+	score float32
+	rank  float32 // value candidates are sorted by, see suggestionCorpus.rank
+}
+
+// findSimilarDomains finds the domains of the corpus similar to the domain according to the typo model,
+// best ranked first, see suggestionCorpus.rank. exact reports whether domain itself is one of the domains.
+func findSimilarDomains(domain string, corpus *suggestionCorpus, threshold float32, model *typoModel, popularityWeight float32) (similar []similarity, exact bool) {
+	if domain == "" || len(corpus.domains) == 0 {
+		return nil, false
+	}
+	if corpus.domains[domain] {
+		return []similarity{{value: domain, score: 1}}, true
+	}
+
+	length := utf8.RuneCountInString(domain)
+	check := func(d string) {
+		if model.maxSimilarity(length, utf8.RuneCountInString(d)) < threshold {
+			return
+		}
+		score := model.similarity(domain, d)
+		if score >= threshold {
+			similar = append(similar, similarity{value: d, score: score, rank: corpus.rank(d, score, model, popularityWeight)})
+		}
+	}
+	if radius := model.searchRadius(length, threshold); radius >= 0 {
+		corpus.search(domain, radius, check)
+	} else {
+		for d := range corpus.domains {
+			check(d)
+		}
+	}
+
+	sort.Slice(similar, func(i, j int) bool {
+		if similar[i].rank != similar[j].rank {
+			return similar[i].rank > similar[j].rank
+		}
+		return preferDomain(similar[i].value, similar[j].value)
+	})
+	return similar, false
+}
+
+// withoutValue removes value from similar
+func withoutValue(similar []similarity, value string) []similarity {
+	ret := similar[:0]
+	for _, s := range similar {
+		if s.value != value {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
 
-	return ""
+// ConfigureSuggestions sets the thresholds and the number of suggestions used by SuggestDomain and SuggestDomains
+func (v *Verifier) ConfigureSuggestions(config SuggestionConfig) *Verifier {
+	v.suggestionConfig = config.withDefaults()
+	return v
 }
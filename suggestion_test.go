@@ -1,7 +1,11 @@
 package emailverifier
 
 import (
+	"context"
+	"net"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -47,3 +51,163 @@ func TestSuggestDomainOK_SLD(t *testing.T) {
 	ret := verifier.SuggestDomain(domain)
 	assert.Equal(t, "hotmail.aftership", ret)
 }
+
+func TestSuggestDomainOK_TransposedPublicSuffix(t *testing.T) {
+	// every rule is ranked by the same transposition aware score, "yahoo.co.ku" is a single swap of "yahoo.co.uk"
+	assert.Equal(t, "yahoo.co.uk", verifier.SuggestDomain("yahoo.co.ku"))
+}
+
+func TestSuggestDomainOK_TieBrokenByPopularity(t *testing.T) {
+	// hotmail.com and hotmail.co are equally similar, the most popular one is suggested
+	assert.Equal(t, "hotmail.com", verifier.SuggestDomain("hotmail.con"))
+
+	similar, _ := findSimilarDomains("hotmail.con", newSuggestionCorpus(freeDomains), domainThreshold, levenshteinModel, defaultPopularityWeight)
+	assert.Equal(t, "hotmail.com", similar[0].value)
+}
+
+func TestSuggestDomainsOK_Ranked(t *testing.T) {
+	v := NewVerifier().ConfigureSuggestions(SuggestionConfig{DomainThreshold: 0.7, MaxSuggestions: 2})
+
+	ret := v.SuggestDomains("gmai.com")
+	assert.Len(t, ret, 2)
+	assert.Equal(t, DomainSuggestion{Domain: "gmail.com", Score: ret[0].Score, Rule: SuggestionRuleDomain}, ret[0])
+	assert.InDelta(t, 0.89, ret[0].Score, 0.01)
+	assert.GreaterOrEqual(t, ret[0].Score, ret[1].Score)
+	assert.GreaterOrEqual(t, ret[1].Score, float32(0.7))
+}
+
+func TestSuggestDomainsOK_Rules(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string
+		rule   string
+	}{
+		{domain: "gmaii.com", want: "gmail.com", rule: SuggestionRuleDomain},
+		{domain: "homail.aftership", want: "hotmail.aftership", rule: SuggestionRuleSLD},
+		{domain: "gmail.edd", want: "gmail.edu", rule: SuggestionRuleTLD},
+		{domain: "mail.homail.con", want: "mail.hotmail.com", rule: SuggestionRuleSLDAndTLD},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			ret := verifier.SuggestDomains(tt.domain)
+			if assert.NotEmpty(t, ret) {
+				assert.Equal(t, tt.want, ret[0].Domain)
+				assert.Equal(t, tt.rule, ret[0].Rule)
+			}
+			assert.Equal(t, tt.want, verifier.SuggestDomain(tt.domain))
+		})
+	}
+}
+
+func TestSuggestDomainsOK_None(t *testing.T) {
+	assert.Nil(t, verifier.SuggestDomains("gmail.com"))
+	assert.Nil(t, verifier.SuggestDomains("yahoo.co.uk"))
+	assert.Nil(t, verifier.SuggestDomains(""))
+}
+
+func TestSuggestDomainsOK_Thresholds(t *testing.T) {
+	strict := NewVerifier().ConfigureSuggestions(SuggestionConfig{
+		DomainThreshold:      0.95,
+		SecondLevelThreshold: 0.95,
+		TopLevelThreshold:    0.95,
+	})
+	assert.Empty(t, strict.SuggestDomains("gmaii.com"))
+	assert.Empty(t, strict.SuggestDomains("gmail.edd"))
+}
+
+func TestSuggestDomainsOK_PublicSuffixes(t *testing.T) {
+	tests := []struct {
+		domain, want string
+	}{
+		{domain: "example.co.ku", want: "example.co.uk"},
+		{domain: "mail.example.co.ku", want: "mail.example.co.uk"},
+		{domain: "example.con.br", want: "example.com.br"},
+		{domain: "example.ocm", want: "example.com"},
+		// not a common top level domain, corrected with the full table
+		{domain: "example.shpo", want: "example.shop"},
+		{domain: "foo.bar.baz", want: "foo.bar.biz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			assert.Contains(t, suggestedDomains(verifier.SuggestDomains(tt.domain)), tt.want)
+		})
+	}
+
+	// existing suffixes of several labels are kept
+	assert.Empty(t, verifier.SuggestDomains("example.com.br"))
+	assert.Empty(t, verifier.SuggestDomains("example.co.uk"))
+}
+
+func TestSuggestDomainsOK_RequireMX(t *testing.T) {
+	resolver := stubResolver{
+		"example.co.uk":  {{Host: "mx.example.co.uk."}},
+		"example.com.br": {{Host: "mx.example.com.br."}},
+	}
+	v := NewVerifier().UseResolver(resolver).ConfigureSuggestions(SuggestionConfig{RequireMX: true})
+
+	assert.Equal(t, []string{"example.co.uk"}, suggestedDomains(v.SuggestDomains("example.co.ku")))
+	assert.Empty(t, v.SuggestDomains("example.shpo"))
+	assert.Equal(t, "john@example.co.uk", v.SuggestAddress("john@example.co.ku"))
+
+	// the typed domain does not exist, its MX lookup fails
+	ret, _ := v.EnableDomainSuggest().Verify(context.Background(), "john@example.con.br")
+	if assert.NotNil(t, ret) {
+		assert.Equal(t, "example.com.br", ret.Suggestion)
+		assert.Equal(t, "john@example.com.br", ret.AddressSuggestion)
+	}
+}
+
+func TestSuggestDomainsContext_RequireMX(t *testing.T) {
+	resolver := &hangingResolver{}
+	v := NewVerifier().UseResolver(resolver).ConfigureSuggestions(SuggestionConfig{RequireMX: true, MaxSuggestions: 5})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	assert.Empty(t, v.SuggestDomainsContext(ctx, "gmial.con"))
+	assert.Less(t, time.Since(start), suggestionMXTimeout)
+	assert.Greater(t, resolver.maxInFlight(), 1)
+}
+
+// hangingResolver answers no lookup until its context is done, it records how many lookups run at once
+type hangingResolver struct {
+	mu       sync.Mutex
+	inFlight int
+	max      int
+}
+
+func (r *hangingResolver) LookupMX(ctx context.Context, _ string) ([]*net.MX, error) {
+	r.mu.Lock()
+	r.inFlight++
+	r.max = max(r.max, r.inFlight)
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		r.inFlight--
+		r.mu.Unlock()
+	}()
+
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (r *hangingResolver) maxInFlight() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.max
+}
+
+func TestLevelSplits(t *testing.T) {
+	assert.Equal(t, [][2]string{{"gmail", "com"}}, levelSplits("gmail.com", "gmail", "com"))
+	assert.Equal(t, [][2]string{{"co", "ku"}, {"example", "co.ku"}}, levelSplits("example.co.ku", "co", "ku"))
+	assert.Equal(t, [][2]string{{"example", "co.uk"}}, levelSplits("example.co.uk", "example", "co.uk"))
+	assert.Equal(t, [][2]string{{"example", "com"}}, levelSplits("mail.example.com", "example", "com"))
+}
+
+func TestIsPublicSuffix(t *testing.T) {
+	assert.True(t, isPublicSuffix("com"))
+	assert.True(t, isPublicSuffix("co.uk"))
+	assert.True(t, isPublicSuffix("shop"))
+	assert.False(t, isPublicSuffix("co.ku"))
+	assert.False(t, isPublicSuffix("shpo"))
+}
@@ -0,0 +1,140 @@
+package emailverifier
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Names of the built-in syntax profiles
+const (
+	SyntaxDefault        = "default"         // the historical rules of this package, quoted and Unicode local parts are accepted
+	SyntaxHTML5          = "html5"           // the rules of an HTML5 `<input type="email">` form field
+	SyntaxRFC5321        = "rfc5321"         // strict RFC 5321 mailbox rules, ASCII only
+	SyntaxRFC5322Lenient = "rfc5322-lenient" // RFC 5322 addr-spec with obsolete dots in local parts and RFC 6532 Unicode
+	SyntaxPractical      = "practical"       // the addresses real-world mailbox providers hand out, no quoted local parts
+)
+
+const (
+	atextString        = "a-zA-Z0-9!#$%&'*+/=?^_`{|}~\\-"
+	ldhLabelString     = "[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?"
+	unicodeRangeString = "\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}"
+
+	html5RegexString = "^[" + atextString + ".]+@" + ldhLabelString + "(?:\\." + ldhLabelString + ")*$"
+
+	rfc5321RegexString = "^(?:[" + atextString + "]+(?:\\.[" + atextString + "]+)*" +
+		"|\"(?:[\\x20\\x21\\x23-\\x5b\\x5d-\\x7e]|\\\\[\\x20-\\x7e])*\")" +
+		"@" + ldhLabelString + "(?:\\." + ldhLabelString + ")*$"
+
+	rfc5322LenientRegexString = "^(?:[" + atextString + "." + unicodeRangeString + "]+" +
+		"|\"(?:[^\"\\\\\\r\\n]|\\\\.)*\")" +
+		"@[a-zA-Z0-9" + unicodeRangeString + "-]+(?:\\.[a-zA-Z0-9" + unicodeRangeString + "-]+)*\\.?$"
+
+	practicalRegexString = "^[a-zA-Z0-9_%+-]+(?:\\.[a-zA-Z0-9_%+-]+)*@(?:" + ldhLabelString + "\\.)+[a-zA-Z]{2,63}$"
+)
+
+// SyntaxProfile is a named set of rules used by ParseAddress to decide whether an email address is valid.
+// Register custom profiles with RegisterSyntaxProfile and select them with Verifier.UseSyntaxProfile.
+type SyntaxProfile struct {
+	Pattern              *regexp.Regexp // the whole address must match the pattern
+	AllowQuotedLocalPart bool           // whether local parts such as `"john doe"` are accepted
+	AllowUnicode         bool           // whether internationalized addresses (RFC 6531) are accepted
+	MaxLocalPartLength   int            // maximum length of the local part in octets, 0 means unlimited
+	MaxDomainLength      int            // maximum length of the domain in octets, 0 means unlimited
+	MaxLength            int            // maximum length of the whole address in octets, 0 means unlimited
+
+	// Validate optionally checks rules which cannot be expressed by the fields above,
+	// it is called with the local part and the domain of an address that matched Pattern.
+	Validate func(username, domain string) error
+}
+
+var defaultSyntaxProfile = SyntaxProfile{
+	Pattern:              emailRegex,
+	AllowQuotedLocalPart: true,
+	AllowUnicode:         true,
+}
+
+var (
+	syntaxProfilesMu sync.RWMutex
+	syntaxProfiles   = map[string]SyntaxProfile{
+		SyntaxDefault: defaultSyntaxProfile,
+		SyntaxHTML5: {
+			Pattern: regexp.MustCompile(html5RegexString),
+		},
+		SyntaxRFC5321: {
+			Pattern:              regexp.MustCompile(rfc5321RegexString),
+			AllowQuotedLocalPart: true,
+			MaxLocalPartLength:   64,
+			MaxDomainLength:      255,
+			MaxLength:            254,
+		},
+		SyntaxRFC5322Lenient: {
+			Pattern:              regexp.MustCompile(rfc5322LenientRegexString),
+			AllowQuotedLocalPart: true,
+			AllowUnicode:         true,
+		},
+		SyntaxPractical: {
+			Pattern:            regexp.MustCompile(practicalRegexString),
+			MaxLocalPartLength: 64,
+			MaxDomainLength:    255,
+			MaxLength:          254,
+		},
+	}
+)
+
+// RegisterSyntaxProfile registers a syntax profile under name so that verifiers can select it,
+// registering a name again replaces the previous profile.
+func RegisterSyntaxProfile(name string, profile SyntaxProfile) error {
+	if name == "" {
+		return errors.New("syntax profile name must not be empty")
+	}
+	if profile.Pattern == nil {
+		return fmt.Errorf("syntax profile %q has no pattern", name)
+	}
+
+	syntaxProfilesMu.Lock()
+	defer syntaxProfilesMu.Unlock()
+	syntaxProfiles[name] = profile
+	return nil
+}
+
+// lookupSyntaxProfile returns the syntax profile registered under name
+func lookupSyntaxProfile(name string) (SyntaxProfile, bool) {
+	syntaxProfilesMu.RLock()
+	defer syntaxProfilesMu.RUnlock()
+	profile, ok := syntaxProfiles[name]
+	return profile, ok
+}
+
+// match reports whether email matches the pattern of the profile
+func (p SyntaxProfile) match(email string) bool {
+	if p.Pattern == nil {
+		return emailRegex.MatchString(email)
+	}
+	return p.Pattern.MatchString(email)
+}
+
+// check applies the rules of the profile that go beyond its pattern
+func (p SyntaxProfile) check(email, username, domain string) error {
+	if !p.AllowUnicode && !isASCII(email) {
+		return errors.New("internationalized addresses are not allowed")
+	}
+	if !p.AllowQuotedLocalPart && strings.HasPrefix(username, "\"") {
+		return errors.New("quoted local parts are not allowed")
+	}
+	if p.MaxLocalPartLength > 0 && len(username) > p.MaxLocalPartLength {
+		return fmt.Errorf("local part exceeds %d octets", p.MaxLocalPartLength)
+	}
+	if p.MaxDomainLength > 0 && len(domain) > p.MaxDomainLength {
+		return fmt.Errorf("domain exceeds %d octets", p.MaxDomainLength)
+	}
+	if p.MaxLength > 0 && len(email) > p.MaxLength {
+		return fmt.Errorf("address exceeds %d octets", p.MaxLength)
+	}
+	if p.Validate != nil {
+		return p.Validate(username, domain)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package emailverifier
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntaxProfiles(t *testing.T) {
+	tests := []struct {
+		email string
+		valid map[string]bool
+	}{
+		{
+			email: "john.doe@example.com",
+			valid: map[string]bool{SyntaxDefault: true, SyntaxHTML5: true, SyntaxRFC5321: true, SyntaxRFC5322Lenient: true, SyntaxPractical: true},
+		},
+		{
+			email: `"john doe"@example.com`,
+			valid: map[string]bool{SyntaxDefault: true, SyntaxHTML5: false, SyntaxRFC5321: true, SyntaxRFC5322Lenient: true, SyntaxPractical: false},
+		},
+		{
+			email: "john..doe@example.com",
+			valid: map[string]bool{SyntaxDefault: false, SyntaxHTML5: true, SyntaxRFC5321: false, SyntaxRFC5322Lenient: true, SyntaxPractical: false},
+		},
+		{
+			email: "用户@例子.广告",
+			valid: map[string]bool{SyntaxDefault: true, SyntaxHTML5: false, SyntaxRFC5321: false, SyntaxRFC5322Lenient: true, SyntaxPractical: false},
+		},
+		{
+			email: "john@localhost",
+			valid: map[string]bool{SyntaxDefault: false, SyntaxHTML5: true, SyntaxRFC5321: true, SyntaxRFC5322Lenient: true, SyntaxPractical: false},
+		},
+		{
+			email: strings.Repeat("a", 65) + "@example.com",
+			valid: map[string]bool{SyntaxDefault: true, SyntaxHTML5: true, SyntaxRFC5321: false, SyntaxRFC5322Lenient: true, SyntaxPractical: false},
+		},
+	}
+	for _, tt := range tests {
+		for name, want := range tt.valid {
+			t.Run(name+" "+tt.email, func(t *testing.T) {
+				v := NewVerifier()
+				require.NoError(t, v.UseSyntaxProfile(name).Err())
+				assert.Equal(t, want, v.ParseAddress(tt.email).Valid)
+			})
+		}
+	}
+}
+
+func TestUseSyntaxProfile_Unknown(t *testing.T) {
+	v := NewVerifier().UseSyntaxProfile("unknown_profile")
+	assert.EqualError(t, v.Err(), "unknown syntax profile: unknown_profile")
+
+	// the previous profile is kept, but the verifier refuses to verify
+	assert.True(t, v.ParseAddress("john@example.com").Valid)
+	_, err := v.Verify(context.Background(), "john@example.com")
+	assert.ErrorContains(t, err, "unknown syntax profile: unknown_profile")
+}
+
+func TestRegisterSyntaxProfile(t *testing.T) {
+	err := RegisterSyntaxProfile("corporate", SyntaxProfile{
+		Pattern: regexp.MustCompile(practicalRegexString),
+		Validate: func(username, domain string) error {
+			if domain != "aftership.com" {
+				return errors.New("only corporate addresses are allowed")
+			}
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	v := NewVerifier()
+	require.NoError(t, v.UseSyntaxProfile("corporate").Err())
+	assert.True(t, v.ParseAddress("john@aftership.com").Valid)
+
+	ret := v.ParseAddress("john@example.com")
+	assert.False(t, ret.Valid)
+	assert.Equal(t, "only corporate addresses are allowed", ret.Error)
+}
+
+func TestRegisterSyntaxProfile_Invalid(t *testing.T) {
+	assert.Error(t, RegisterSyntaxProfile("", SyntaxProfile{Pattern: emailRegex}))
+	assert.Error(t, RegisterSyntaxProfile("no_pattern", SyntaxProfile{}))
+}
+
+func TestSyntaxProfile_NoQuotedLocalPart(t *testing.T) {
+	profile := defaultSyntaxProfile
+	profile.AllowQuotedLocalPart = false
+	require.NoError(t, RegisterSyntaxProfile("no_quotes", profile))
+
+	v := NewVerifier()
+	require.NoError(t, v.UseSyntaxProfile("no_quotes").Err())
+	ret := v.ParseAddress(`"john"@example.com`)
+	assert.False(t, ret.Valid)
+	assert.Equal(t, "quoted local parts are not allowed", ret.Error)
+}
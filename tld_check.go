@@ -14,3 +14,25 @@ func TopLevelDomainExists(email string) bool {
 	_, ok2 := CountryCodeTLDs[tld]
 	return ok1 || ok2
 }
+
+// topLevelDomains are the TLDs known to TopLevelDomainExists, it is the default TLD list of a Verifier
+var topLevelDomains = func() map[string]bool {
+	tlds := make(map[string]bool, len(GenericTLDs)+len(CountryCodeTLDs))
+	for tld := range GenericTLDs {
+		tlds[tld] = true
+	}
+	for tld := range CountryCodeTLDs {
+		tlds[tld] = true
+	}
+	return tlds
+}()
+
+// topLevelDomainExists checks if the TLD exists according to the TLD list of the verifier
+func (v *Verifier) topLevelDomainExists(domain string) bool {
+	domain = strings.ToLower(domain)
+	lastDot := strings.LastIndex(domain, ".")
+	if lastDot == -1 {
+		return false
+	}
+	return v.topLevelDomains.contains(domain[lastDot+1:])
+}
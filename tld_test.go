@@ -0,0 +1,49 @@
+package emailverifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupTLD(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   TLDInfo
+		ok     bool
+	}{
+		{domain: "com", want: TLDInfo{Name: "com", Unicode: "com", Type: TLDGeneric, Manager: "VeriSign Global Registry Services"}, ok: true},
+		{domain: "example.co.UK.", want: TLDInfo{Name: "uk", Unicode: "uk", Type: TLDCountryCode}, ok: true},
+		{domain: "harvard.edu", want: TLDInfo{Name: "edu", Unicode: "edu", Type: TLDSponsored, Manager: "EDUCAUSE"}, ok: true},
+		{domain: "biz", want: TLDInfo{Name: "biz", Unicode: "biz", Type: TLDGenericRestricted, Manager: "Neustar, Inc."}, ok: true},
+		{domain: "in-addr.arpa", want: TLDInfo{Name: "arpa", Unicode: "arpa", Type: TLDInfrastructure}, ok: true},
+		{domain: "google", want: TLDInfo{Name: "google", Unicode: "google", Type: TLDBrand, Manager: "Charleston Road Registry Inc."}, ok: true},
+		{domain: "пример.рф", want: TLDInfo{Name: "xn--p1ai", Unicode: "рф", Type: TLDCountryCode, Manager: "Coordination Center for TLD RU"}, ok: true},
+		// delegated after the IANA list, taken from the Public Suffix List
+		{domain: "example.music", want: TLDInfo{Name: "music", Unicode: "music", Type: TLDGeneric}, ok: true},
+		{domain: "example.onion"},
+		{domain: "example.con"},
+		{domain: "localhost"},
+		{domain: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			got, ok := LookupTLD(tt.domain)
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTLDTables(t *testing.T) {
+	assert.Contains(t, GenericTLDs, "com")
+	assert.Contains(t, GenericTLDs, "edu")
+	assert.Contains(t, GenericTLDs, "google")
+	assert.NotContains(t, GenericTLDs, "uk")
+	assert.Contains(t, CountryCodeTLDs, "uk")
+	assert.Contains(t, CountryCodeTLDs, "xn--p1ai")
+	assert.Equal(t, map[string]struct{}{"arpa": {}}, InfrastructureTLDs)
+	assert.NotEmpty(t, TLDListVersion())
+}
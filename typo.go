@@ -0,0 +1,243 @@
+package emailverifier
+
+import (
+	"math"
+
+	"github.com/hbollon/go-edlib"
+)
+
+// KeyboardLayout is a keyboard layout used to weigh typos in domain suggestions
+type KeyboardLayout string
+
+const (
+	KeyboardQWERTY KeyboardLayout = "qwerty"
+	KeyboardAZERTY KeyboardLayout = "azerty"
+	KeyboardQWERTZ KeyboardLayout = "qwertz"
+)
+
+const (
+	// likelyTypoCost is the cost of the edits people often make by mistake: hitting an adjacent key,
+	// swapping two letters, doubling a letter or missing one of a double letter. Other edits cost 1.
+	likelyTypoCost = 0.5
+	// priorWeight is how much the popularity of a domain, from 0 to 1, adds to its similarity when ranking suggestions
+	priorWeight = 0.05
+)
+
+// keyboardRows are the rows of every layout, from the digits row to the bottom row
+var keyboardRows = map[KeyboardLayout][]string{
+	KeyboardQWERTY: {"1234567890-", "qwertyuiop", "asdfghjkl", "zxcvbnm,."},
+	KeyboardAZERTY: {"1234567890", "azertyuiop", "qsdfghjklm", "wxcvbn,;"},
+	KeyboardQWERTZ: {"1234567890", "qwertzuiop", "asdfghjkl", "yxcvbnm,.-"},
+}
+
+// keyboardRowOffsets are the horizontal offsets of the rows, in keys, of a staggered keyboard
+var keyboardRowOffsets = []float64{0, 0.5, 0.75, 1.25}
+
+// typoModels are the typo models of the keyboard layouts
+var typoModels = func() map[KeyboardLayout]*typoModel {
+	models := make(map[KeyboardLayout]*typoModel, len(keyboardRows))
+	for layout, rows := range keyboardRows {
+		models[layout] = &typoModel{adjacent: adjacentKeys(rows)}
+	}
+	return models
+}()
+
+// levenshteinModel is the typo model used without keyboard layout, every edit costs the same
+var levenshteinModel = &typoModel{}
+
+// damerauModel is levenshteinModel where swapping two letters is a single edit, such as "co.ku" for "co.uk"
+var damerauModel = &typoModel{transposition: true}
+
+// typoModel scores how likely a typed domain is a misspelling of a known domain
+type typoModel struct {
+	adjacent      *[128][128]bool // ASCII keys next to each other, nil for plain Levenshtein
+	transposition bool            // whether swapping two letters is a single edit without keyboard layout
+}
+
+// typoModelFor returns the typo model of the layout, plain Levenshtein if layout is empty or unknown
+func typoModelFor(layout KeyboardLayout) *typoModel {
+	if m, ok := typoModels[layout]; ok {
+		return m
+	}
+	return levenshteinModel
+}
+
+// withTranspositions returns the model where swapping two letters is a likely typo,
+// keyboard aware models already count it as one
+func (m *typoModel) withTranspositions() *typoModel {
+	if m.adjacent != nil {
+		return m
+	}
+	return damerauModel
+}
+
+// adjacentKeys returns the keys next to each other on a staggered keyboard with rows
+func adjacentKeys(rows []string) *[128][128]bool {
+	type position struct {
+		row int
+		x   float64
+	}
+	positions := make(map[rune]position)
+	for row, keys := range rows {
+		for col, key := range []rune(keys) {
+			positions[key] = position{row: row, x: float64(col) + keyboardRowOffsets[row]}
+		}
+	}
+
+	adjacent := new([128][128]bool)
+	for a, pa := range positions {
+		for b, pb := range positions {
+			dx := math.Abs(pa.x - pb.x)
+			switch pa.row - pb.row {
+			case 0:
+				if dx == 1 {
+					adjacent[a][b] = true
+				}
+			case -1, 1:
+				if dx <= 1 {
+					adjacent[a][b] = true
+				}
+			}
+		}
+	}
+	return adjacent
+}
+
+// similarity returns how similar the typed string is to the candidate, from 0 to 1
+func (m *typoModel) similarity(typed, candidate string) float32 {
+	if m.adjacent == nil {
+		algorithm := edlib.Levenshtein
+		if m.transposition {
+			algorithm = edlib.OSADamerauLevenshtein
+		}
+		score, _ := edlib.StringsSimilarity(typed, candidate, algorithm)
+		return score
+	}
+
+	a, b := []rune(typed), []rune(candidate)
+	maxLen := max(len(a), len(b))
+	if maxLen == 0 {
+		return 1
+	}
+	return 1 - m.distance(a, b)/float32(maxLen)
+}
+
+// isAdjacent reports whether the keys x and y are next to each other
+func (m *typoModel) isAdjacent(x, y rune) bool {
+	return x < 128 && y < 128 && m.adjacent[x][y]
+}
+
+// distance is the weighted Damerau-Levenshtein (optimal string alignment) distance between the typed string a
+// and the candidate b, likely typos cost likelyTypoCost
+func (m *typoModel) distance(a, b []rune) float32 {
+	// only the last three rows of the matrix are needed
+	prev2 := make([]float32, len(b)+1)
+	prev := make([]float32, len(b)+1)
+	cur := make([]float32, len(b)+1)
+	missing := make([]float32, len(b))
+	for j := range b {
+		missing[j] = m.missingCost(b, j)
+		prev[j+1] = prev[j] + missing[j]
+	}
+
+	for i := 1; i <= len(a); i++ {
+		extra := m.extraCost(a, i-1)
+		cur[0] = prev[0] + extra
+		for j := 1; j <= len(b); j++ {
+			cost := min(
+				prev[j]+extra,
+				cur[j-1]+missing[j-1],
+				prev[j-1]+m.substitutionCost(a[i-1], b[j-1]),
+			)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] && a[i-1] != a[i-2] {
+				cost = min(cost, prev2[j-2]+likelyTypoCost)
+			}
+			cur[j] = cost
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+// substitutionCost is the cost of typing x instead of y
+func (m *typoModel) substitutionCost(x, y rune) float32 {
+	switch {
+	case x == y:
+		return 0
+	case m.isAdjacent(x, y):
+		return likelyTypoCost
+	default:
+		return 1
+	}
+}
+
+// extraCost is the cost of the extra typed character a[i], which is likely when it doubles
+// or is next to one of the surrounding characters, such as "gmaiil" or "gmaoil"
+func (m *typoModel) extraCost(a []rune, i int) float32 {
+	if i > 0 && (a[i] == a[i-1] || m.isAdjacent(a[i], a[i-1])) {
+		return likelyTypoCost
+	}
+	if i+1 < len(a) && m.isAdjacent(a[i], a[i+1]) {
+		return likelyTypoCost
+	}
+	return 1
+}
+
+// missingCost is the cost of missing the character b[j], which is likely when it is one of a double letter,
+// such as "yaho" for "yahoo"
+func (m *typoModel) missingCost(b []rune, j int) float32 {
+	if (j > 0 && b[j] == b[j-1]) || (j+1 < len(b) && b[j] == b[j+1]) {
+		return likelyTypoCost
+	}
+	return 1
+}
+
+// maxSimilarity returns the highest similarity of strings of n and k characters,
+// every added or missing character costs at least likelyTypoCost with a keyboard layout, 1 otherwise
+func (m *typoModel) maxSimilarity(n, k int) float32 {
+	longest := max(n, k)
+	if longest == 0 {
+		return 1
+	}
+	cost := float32(1)
+	if m.adjacent != nil {
+		cost = likelyTypoCost
+	}
+	return 1 - cost*float32(abs(n-k))/float32(longest)
+}
+
+// searchRadius returns the Levenshtein distance to a typed string of n characters
+// within which lie all the candidates at least threshold similar to it, -1 if every candidate must be checked.
+func (m *typoModel) searchRadius(n int, threshold float32) int {
+	// Every edit of the weighted distance costs at least likelyTypoCost,
+	// and a transposition takes two edits of the Levenshtein distance
+	edits := 1.0
+	switch {
+	case m.adjacent != nil:
+		edits = 2 / likelyTypoCost
+	case m.transposition:
+		edits = 2
+	}
+
+	// similarity >= threshold means distance <= (1-threshold) * max(n, len(candidate)),
+	// and the length of the candidate differs from n by at most the distance
+	r := edits * float64(1-threshold)
+	if r >= 1 {
+		return -1
+	}
+	radius := int(r*float64(n)/(1-r) + 1e-6)
+	if radius >= n {
+		// the index would visit nearly every candidate
+		return -1
+	}
+	return radius
+}
+
+// rank returns the value suggestions are sorted by: the similarity,
+// slightly raised for popular domains when the model is keyboard aware
+func (m *typoModel) rank(domain string, score float32) float32 {
+	if m.adjacent == nil {
+		return score
+	}
+	return score + popularDomains[domain]*priorWeight
+}
@@ -0,0 +1,79 @@
+package emailverifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdjacentKeys(t *testing.T) {
+	qwerty := typoModelFor(KeyboardQWERTY).adjacent
+	assert.True(t, qwerty['a']['s'])
+	assert.True(t, qwerty['a']['q'])
+	assert.True(t, qwerty['a']['z'])
+	assert.False(t, qwerty['a']['p'])
+	assert.False(t, qwerty['a']['x'])
+
+	azerty := typoModelFor(KeyboardAZERTY).adjacent
+	assert.True(t, azerty['a']['z'])
+	assert.True(t, azerty['a']['q'])
+	assert.False(t, azerty['a']['s'])
+
+	qwertz := typoModelFor(KeyboardQWERTZ).adjacent
+	assert.True(t, qwertz['z']['t'])
+	assert.False(t, qwertz['y']['t'])
+	assert.True(t, qwertz['y']['a'])
+}
+
+func TestTypoModel_Similarity(t *testing.T) {
+	m := typoModelFor(KeyboardQWERTY)
+
+	// adjacent key
+	assert.Greater(t, m.similarity("gmsil", "gmail"), m.similarity("gmpil", "gmail"))
+	// transposition
+	assert.Equal(t, float32(0.9), m.similarity("gmial", "gmail"))
+	// doubled letter
+	assert.Equal(t, float32(1)-0.5/6, m.similarity("gmaill", "gmail"))
+	// missing one of a double letter
+	assert.Equal(t, float32(1)-0.5/5, m.similarity("yaho", "yahoo"))
+	// unrelated substitution
+	assert.Equal(t, float32(0.8), m.similarity("gmpil", "gmail"))
+	assert.Equal(t, float32(1), m.similarity("gmail", "gmail"))
+
+	// without layout every edit costs the same
+	plain := typoModelFor("")
+	assert.Equal(t, plain.similarity("gmsil", "gmail"), plain.similarity("gmpil", "gmail"))
+	assert.Same(t, levenshteinModel, typoModelFor("dvorak"))
+}
+
+func TestTypoModel_Rank(t *testing.T) {
+	m := typoModelFor(KeyboardQWERTY)
+	assert.Greater(t, m.rank("gmail.com", 0.9), m.rank("gmai.com", 0.9))
+	assert.Equal(t, float32(0.9), levenshteinModel.rank("gmail.com", 0.9))
+}
+
+func TestSuggestDomainsOK_KeyboardLayout(t *testing.T) {
+	v := NewVerifier().ConfigureSuggestions(SuggestionConfig{KeyboardLayout: KeyboardQWERTY})
+
+	assert.Equal(t, "gmail.com", v.SuggestDomain("gmsil.com"))
+	assert.Equal(t, "gmail.com", v.SuggestDomain("gmial.com"))
+	assert.Equal(t, "outlook.com", v.SuggestDomain("outlok.com"))
+	assert.Equal(t, "hotmail.com", v.SuggestDomain("hotmial.com"))
+	assert.Equal(t, "", v.SuggestDomain("gmail.com"))
+}
+
+func TestTypoModel_Transpositions(t *testing.T) {
+	assert.Same(t, damerauModel, levenshteinModel.withTranspositions())
+	qwerty := typoModelFor(KeyboardQWERTY)
+	assert.Same(t, qwerty, qwerty.withTranspositions())
+
+	assert.InDelta(t, 0.8, damerauModel.similarity("co.ku", "co.uk"), 1e-6)
+	assert.InDelta(t, 0.6, levenshteinModel.similarity("co.ku", "co.uk"), 1e-6)
+}
+
+func TestTypoModel_MaxSimilarity(t *testing.T) {
+	assert.Equal(t, float32(0.5), levenshteinModel.maxSimilarity(2, 4))
+	assert.Equal(t, float32(0.75), typoModelFor(KeyboardQWERTY).maxSimilarity(4, 2))
+	assert.Equal(t, float32(1), levenshteinModel.maxSimilarity(0, 0))
+	assert.GreaterOrEqual(t, levenshteinModel.maxSimilarity(9, 8), levenshteinModel.similarity("gmail.com", "gmai.com"))
+}
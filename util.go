@@ -3,23 +3,31 @@ package emailverifier
 import (
 	"crypto/md5" //nolint:gosec
 	"encoding/hex"
-	"reflect"
 	"strings"
+	"unicode/utf8"
 
 	"golang.org/x/net/idna"
 )
 
-// splitDomain splits domain and returns sld and tld
+// splitDomain splits domain and returns sld and tld,
+// the tld is the public suffix of the domain, which may consist of several labels such as "co.uk"
 func splitDomain(domain string) (string, string) {
-	parts := strings.Split(domain, ".")
-	n := len(parts)
-	if len(parts) >= 2 {
-		return parts[n-2], parts[n-1]
+	domain = strings.TrimSuffix(domain, ".")
+	tld := publicSuffix(domainToASCII(domain))
+	if tld == "" {
+		return "", ""
 	}
-	return "", parts[0]
+	tld = lastLabels(domain, strings.Count(tld, ".")+1)
+	if len(domain) <= len(tld) {
+		return "", tld
+	}
+
+	rest := strings.TrimSuffix(domain[:len(domain)-len(tld)], ".")
+	return rest[strings.LastIndex(rest, ".")+1:], tld
 }
 
-// domainToASCII converts any internationalized domain names to ASCII
+// domainToASCII converts any internationalized domain names to ASCII, returning domain unchanged if it cannot,
+// it is only meant for lookups in the lists, use toASCII before querying DNS or storing a domain
 // reference: https://en.wikipedia.org/wiki/Punycode
 func domainToASCII(domain string) string {
 	asciiDomain, err := idna.ToASCII(domain)
@@ -29,21 +37,28 @@ func domainToASCII(domain string) string {
 	return asciiDomain
 }
 
-// callJobFuncWithParams convert jobFunc and prams to a specific function and call it
-func callJobFuncWithParams(jobFunc interface{}, params []interface{}) []reflect.Value {
-	typ := reflect.TypeOf(jobFunc)
-	if typ.Kind() != reflect.Func {
-		return nil
-	}
-	f := reflect.ValueOf(jobFunc)
-	if len(params) != f.Type().NumIn() {
-		return nil
-	}
-	in := make([]reflect.Value, len(params))
-	for k, param := range params {
-		in[k] = reflect.ValueOf(param)
+// idnaProfile validates and converts domains according to UTS #46 non-transitional processing
+var idnaProfile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.VerifyDNSLength(true),
+	idna.Transitional(false),
+)
+
+// toASCII converts an internationalized domain name to ASCII,
+// unlike domainToASCII it reports domains which are not valid IDNs
+func toASCII(domain string) (string, error) {
+	return idnaProfile.ToASCII(domain)
+}
+
+// isASCII reports whether s only contains ASCII characters
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
 	}
-	return f.Call(in)
+	return true
 }
 
 // getMD5Hash use md5 to encode string
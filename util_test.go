@@ -1,7 +1,6 @@
 package emailverifier
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,28 +19,18 @@ func TestDomainToASCII_NormalDomain(t *testing.T) {
 	assert.Equal(t, domain, ret)
 }
 
-func TestCallJobFuncWithParams_NoOutput(t *testing.T) {
-	f := func(a string) { fmt.Println(a) }
-	ret := callJobFuncWithParams(f, []interface{}{"testing"})
-	assert.Nil(t, ret)
-}
-
-func TestCallJobFuncWithParams_WithOutput(t *testing.T) {
-	f := func(a int) int { return a * (1 + a) }
-	ret := callJobFuncWithParams(f, []interface{}{2})
-	assert.Equal(t, int64(6), ret[0].Int())
-}
+func TestToASCII(t *testing.T) {
+	ret, err := toASCII("доменное.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "xn--d1aca0agade.com", ret)
 
-func TestCallJobFuncForgetParams(t *testing.T) {
-	f := func(a int) int { return a * (1 + a) }
-	ret := callJobFuncWithParams(f, nil)
-	assert.Nil(t, ret)
+	_, err = toASCII("ex_ample.com")
+	assert.Error(t, err)
 }
 
-func TestCallJobFuncWithWrongFunc(t *testing.T) {
-	f := 3
-	ret := callJobFuncWithParams(f, nil)
-	assert.Nil(t, ret)
+func TestIsASCII(t *testing.T) {
+	assert.True(t, isASCII("user.name+tag"))
+	assert.False(t, isASCII("用户"))
 }
 
 func TestSplitDomainNoSLD(t *testing.T) {
@@ -71,3 +60,10 @@ func TestSplitDomainSubDomain(t *testing.T) {
 	assert.Equal(t, "aftership", sld)
 	assert.Equal(t, "com", tld)
 }
+
+func TestSplitDomainPublicSuffix(t *testing.T) {
+	domain := "mail.example.co.uk"
+	sld, tld := splitDomain(domain)
+	assert.Equal(t, "example", sld)
+	assert.Equal(t, "co.uk", tld)
+}
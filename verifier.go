@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
@@ -18,11 +19,26 @@ type Verifier struct {
 	domainSuggestEnabled   bool // whether suggest a most similar correct domain or not (disabled by default)
 	gravatarCheckEnabled   bool // gravatar check enabled or disabled (disabled by default)
 	TopLevelDomainDisabled bool
-	fromEmail              string                     // name to use in the `EHLO:` SMTP command, defaults to "user@example.org"
-	helloName              string                     // email to use in the `MAIL FROM:` SMTP command. defaults to `localhost`
-	schedule               *schedule                  // schedule represents a job schedule
-	proxyURI               string                     // use a SOCKS5 proxy to verify the email,
-	apiVerifiers           map[string]smtpAPIVerifier // currently support gmail & yahoo, further contributions are welcomed.
+	fromEmail              string                 // name to use in the `EHLO:` SMTP command, defaults to "user@example.org"
+	helloName              string                 // email to use in the `MAIL FROM:` SMTP command. defaults to `localhost`
+	refresher              *refresher             // refresher updates the metadata lists periodically
+	proxyURI               string                 // use a SOCKS5 proxy to verify the email,
+	resolver               Resolver               // looks up MX records, defaults to net.DefaultResolver
+	apiVerifiers           map[string]APIVerifier // enabled API verifiers by name, currently support yahoo & microsoft, further contributions are welcomed.
+	apiClient              *http.Client           // HTTP client used by the API verifiers, defaults to http.DefaultClient
+	listClient             *http.Client           // HTTP client used to load and update lists, defaults to http.DefaultClient
+	snapshotStatus         SnapshotStatus         // outcome of loading the snapshot directory, see SnapshotDir
+	syntaxProfile          SyntaxProfile          // rules used by ParseAddress, defaults to SyntaxDefault
+	disposableDomains      *list                  // disposable domains, starts out with the embedded data
+	freeDomains            *list                  // free email provider domains, starts out with the embedded data
+	roleAccounts           *list                  // role-based usernames, starts out with the embedded data
+	topLevelDomains        *list                  // top level domains, starts out with the embedded data
+	policy                 *policy                // allow and block rules evaluated before network checks
+	snapshots              *snapshotStore         // persists lists fetched by automatic updates, nil if disabled
+	suggestionConfig       SuggestionConfig       // thresholds and number of domain suggestions
+	corpora                *verifierCorpora       // domains suggested for misspelled domains
+	configErr              error                  // errors of the configuration methods, see Err
+	provenanceEnabled      bool                   // whether report the list entries behind the Free, Disposable and RoleAccount flags (disabled by default)
 
 	// Timeouts
 	connectTimeout   time.Duration // Timeout for establishing connections
@@ -31,27 +47,24 @@ type Verifier struct {
 
 // Result is the result of Email Verification
 type Result struct {
-	Email        string    `json:"email"`          // passed email address
-	Reachable    string    `json:"reachable"`      // an enumeration to describe whether the recipient address is real
-	Syntax       Syntax    `json:"syntax"`         // details about the email address syntax
-	SMTP         *SMTP     `json:"smtp"`           // details about the SMTP response of the email
-	Gravatar     *Gravatar `json:"gravatar"`       // whether have gravatar for the email
-	Suggestion   string    `json:"suggestion"`     // domain suggestion when domain is misspelled
-	Disposable   bool      `json:"disposable"`     // is this a DEA (disposable email address)
-	RoleAccount  bool      `json:"role_account"`   // is account a role-based account
-	Free         bool      `json:"free"`           // is domain a free email domain
-	HasMxRecords bool      `json:"has_mx_records"` // whether MX-Records for the domain
-	TLDExists    bool      `json:"tld_exists"`     // whether the TLD exists
-}
-
-// additional list of disposable domains set via users of this library
-var additionalDisposableDomains = map[string]bool{}
-
-// init loads disposable_domain meta data to disposableSyncDomains which are safe for concurrent use
-func init() {
-	for d := range disposableDomains {
-		disposableSyncDomains.Store(d, struct{}{})
-	}
+	Email        string       `json:"email"`          // passed email address
+	Reachable    string       `json:"reachable"`      // an enumeration to describe whether the recipient address is real
+	Syntax       Syntax       `json:"syntax"`         // details about the email address syntax
+	SMTP         *SMTP        `json:"smtp"`           // details about the SMTP response of the email
+	Gravatar     *Gravatar    `json:"gravatar"`       // whether have gravatar for the email
+	Suggestion   string       `json:"suggestion"`     // domain suggestion when domain is misspelled
+	Disposable   bool         `json:"disposable"`     // is this a DEA (disposable email address)
+	RoleAccount  bool         `json:"role_account"`   // is account a role-based account
+	Free         bool         `json:"free"`           // is domain a free email domain
+	HasMxRecords bool         `json:"has_mx_records"` // whether MX-Records for the domain
+	TLDExists    bool         `json:"tld_exists"`     // whether the TLD exists
+	SpecialUse   bool         `json:"special_use"`    // whether the domain is a special-use domain such as example.com or *.test
+	Confusable   *Confusable  `json:"confusable"`     // homoglyphs found in the email address, nil if there are none
+	Policy       *PolicyMatch `json:"policy"`         // the allow or block rule which decided the result, nil if none matched
+
+	Suggestions       []DomainSuggestion `json:"suggestions,omitempty"`        // ranked domain suggestions when domain is misspelled, the first one is Suggestion
+	AddressSuggestion string             `json:"address_suggestion,omitempty"` // corrected address when the address is invalid or its domain is misspelled
+	Provenance        []ListProvenance   `json:"provenance,omitempty"`         // list entries behind the Free, Disposable and RoleAccount flags, see EnableProvenance
 }
 
 // NewVerifier creates a new email verifier
@@ -61,7 +74,18 @@ func NewVerifier() *Verifier {
 		fromEmail:            defaultFromEmail,
 		helloName:            defaultHelloName,
 		catchAllCheckEnabled: true,
-		apiVerifiers:         map[string]smtpAPIVerifier{},
+		apiVerifiers:         map[string]APIVerifier{},
+		apiClient:            http.DefaultClient,
+		listClient:           http.DefaultClient,
+		syntaxProfile:        defaultSyntaxProfile,
+		disposableDomains:    newDomainList(ListDisposable, disposableDomains),
+		freeDomains:          newDomainList(ListFree, mapSet(freeDomains)),
+		roleAccounts:         newRoleList(ListRole, mapSet(roleAccounts)),
+		topLevelDomains:      newDomainList(ListTLD, mapSet(topLevelDomains)),
+		policy:               newPolicy(),
+		suggestionConfig:     SuggestionConfig{}.withDefaults(),
+		corpora:              newVerifierCorpora(),
+		resolver:             net.DefaultResolver,
 		connectTimeout:       10 * time.Second,
 		operationTimeout:     10 * time.Second,
 	}
@@ -77,14 +101,15 @@ func (v *Verifier) enabledOptions() (c int) {
 	if v.gravatarCheckEnabled {
 		c++
 	}
-	if v.domainSuggestEnabled {
-		c++
-	}
 	return c
 }
 
 // Verify performs address, misc, mx and smtp checks
 func (v *Verifier) Verify(ctx context.Context, email string) (*Result, error) {
+	if v.configErr != nil {
+		return nil, fmt.Errorf("invalid verifier configuration: %w", v.configErr)
+	}
+
 	email = trimLower(email)
 	ret := Result{
 		Email:     email,
@@ -94,18 +119,42 @@ func (v *Verifier) Verify(ctx context.Context, email string) (*Result, error) {
 	syntax := v.ParseAddress(email)
 	ret.Syntax = syntax
 	if !syntax.Valid {
+		if v.domainSuggestEnabled {
+			ret.AddressSuggestion = v.suggestAddress(ctx, email)
+		}
 		return &ret, nil
 	}
 
 	ret.Free = v.IsFreeDomain(syntax.Domain)
 	ret.RoleAccount = v.IsRoleAccount(syntax.Username)
 	ret.Disposable = v.IsDisposable(syntax.Domain)
+	ret.Confusable = v.CheckConfusable(syntax.Username, syntax.Domain)
+	if v.provenanceEnabled {
+		ret.Provenance = v.listProvenance(syntax.Username, syntax.Domain)
+	}
 	if v.domainSuggestEnabled {
-		ret.Suggestion = v.SuggestDomain(syntax.Domain)
+		v.suggest(ctx, &ret, syntax.Domain)
+	}
+
+	// Allowed and blocked addresses are decided regardless of what the mail server says
+	if match := v.policy.evaluate(syntax.Username, syntax.Domain); match != nil {
+		ret.Policy = match
+		ret.Reachable = reachableNo
+		if match.Action == PolicyAllow {
+			ret.Reachable = reachableYes
+		}
+		return &ret, nil
+	}
+
+	// Special-use domains never receive email, there is no point in looking them up
+	if IsSpecialUseDomain(syntax.Domain) {
+		ret.SpecialUse = true
+		ret.Reachable = reachableNo
+		return &ret, nil
 	}
 
 	if !v.TopLevelDomainDisabled {
-		if domainIDNA := domainToASCII(syntax.Domain); !TopLevelDomainExists(domainIDNA) {
+		if domainIDNA := domainToASCII(syntax.Domain); !v.topLevelDomainExists(domainIDNA) {
 			return nil, fmt.Errorf("TLD domain %q does not exist", domainIDNA)
 		}
 		ret.TLDExists = true
@@ -156,13 +205,6 @@ func (v *Verifier) Verify(ctx context.Context, email string) (*Result, error) {
 		return nil
 	})
 
-	g.Go(func() error {
-		if v.domainSuggestEnabled {
-			ret.Suggestion = v.SuggestDomain(syntax.Domain)
-		}
-		return nil
-	})
-
 	if err := g.Wait(); err != nil {
 		return &ret, err
 	}
@@ -171,11 +213,58 @@ func (v *Verifier) Verify(ctx context.Context, email string) (*Result, error) {
 }
 
 // AddDisposableDomains adds additional domains as disposable domains.
+// Subdomains of the domains are disposable as well, entries such as "*.example.com"
+// only mark the subdomains of example.com as disposable.
 func (v *Verifier) AddDisposableDomains(domains []string) *Verifier {
-	for _, d := range domains {
-		additionalDisposableDomains[d] = true
-		disposableSyncDomains.Store(d, struct{}{})
-	}
+	v.disposableDomains.add(domains, SourceCustom)
+	return v
+}
+
+// RemoveDisposableDomains removes domains from the disposable domains of this verifier.
+func (v *Verifier) RemoveDisposableDomains(domains []string) *Verifier {
+	v.disposableDomains.remove(domains)
+	return v
+}
+
+// ReplaceDisposableDomains replaces all disposable domains of this verifier, including the embedded ones.
+func (v *Verifier) ReplaceDisposableDomains(domains []string) *Verifier {
+	v.disposableDomains.replace(domains, SourceCustom)
+	return v
+}
+
+// AddFreeDomains adds additional domains as free email provider domains.
+func (v *Verifier) AddFreeDomains(domains []string) *Verifier {
+	v.freeDomains.add(domains, SourceCustom)
+	return v
+}
+
+// RemoveFreeDomains removes domains from the free email provider domains of this verifier.
+func (v *Verifier) RemoveFreeDomains(domains []string) *Verifier {
+	v.freeDomains.remove(domains)
+	return v
+}
+
+// ReplaceFreeDomains replaces all free email provider domains of this verifier, including the embedded ones.
+func (v *Verifier) ReplaceFreeDomains(domains []string) *Verifier {
+	v.freeDomains.replace(domains, SourceCustom)
+	return v
+}
+
+// AddRoleAccounts adds additional usernames as role-based accounts.
+func (v *Verifier) AddRoleAccounts(usernames []string) *Verifier {
+	v.roleAccounts.add(usernames, SourceCustom)
+	return v
+}
+
+// RemoveRoleAccounts removes usernames from the role-based accounts of this verifier.
+func (v *Verifier) RemoveRoleAccounts(usernames []string) *Verifier {
+	v.roleAccounts.remove(usernames)
+	return v
+}
+
+// ReplaceRoleAccounts replaces all role-based accounts of this verifier, including the embedded ones.
+func (v *Verifier) ReplaceRoleAccounts(usernames []string) *Verifier {
+	v.roleAccounts.replace(usernames, SourceCustom)
 	return v
 }
 
@@ -216,13 +305,13 @@ func (v *Verifier) EnableSMTPCheck() *Verifier {
 // EnableAPIVerifier API verifier is activated when EnableAPIVerifier for the target vendor.
 // ** Please know ** that this is a tricky way (but relatively stable) to check if target vendor's email exists.
 // If you use this feature in a production environment, please ensure that you have sufficient backup measures in place, as this may encounter rate limiting or other API issues.
+// Other vendors can be checked by registering an API verifier with RegisterAPIVerifier.
 func (v *Verifier) EnableAPIVerifier(name string) error {
-	switch name {
-	case YAHOO:
-		v.apiVerifiers[YAHOO] = newYahooAPIVerifier(http.DefaultClient)
-	default:
+	newAPIVerifier, ok := apiVerifierFactories[name]
+	if !ok {
 		return fmt.Errorf("unsupported to enable the API verifier for vendor: %s", name)
 	}
+	v.apiVerifiers[name] = newAPIVerifier()
 	return nil
 }
 
@@ -264,18 +353,77 @@ func (v *Verifier) DisableDomainSuggest() *Verifier {
 
 // EnableAutoUpdateDisposable enables update disposable domains automatically
 func (v *Verifier) EnableAutoUpdateDisposable() *Verifier {
-	v.stopCurrentSchedule()
-	// fetch latest disposable domains before next schedule
-	_ = updateDisposableDomains(disposableDataURL)
-	// update disposable domains records daily
-	v.schedule = newSchedule(24*time.Hour, updateDisposableDomains, disposableDataURL)
-	v.schedule.start()
-	return v
+	return v.EnableAutoUpdate(AutoUpdateConfig{
+		Sources: map[string]string{ListDisposable: disposableDataURL},
+	})
 }
 
 // DisableAutoUpdateDisposable stops previously started schedule job
 func (v *Verifier) DisableAutoUpdateDisposable() *Verifier {
+	return v.DisableAutoUpdate()
+}
+
+// EnableAutoUpdate enables update the metadata lists automatically from the configured sources,
+// the lists are updated once before the next schedule.
+// Entries added to or removed from the lists of this verifier are kept on top of the updated lists.
+// The outcome of every update, including the first one, is reported to OnUpdate and by AutoUpdateStatus.
+func (v *Verifier) EnableAutoUpdate(config AutoUpdateConfig) *Verifier {
+	return v.EnableAutoUpdateContext(context.Background(), config)
+}
+
+// EnableAutoUpdateContext is like EnableAutoUpdate, the first update is bounded by ctx
+// and the automatic updates stop when ctx is done.
+func (v *Verifier) EnableAutoUpdateContext(ctx context.Context, config AutoUpdateConfig) *Verifier {
 	v.stopCurrentSchedule()
+	interval := config.Interval
+	if interval <= 0 {
+		interval = defaultUpdateInterval
+	}
+
+	u := newUpdater(v, config)
+	v.refresher = newRefresher(interval, config.Jitter, config.RetryInterval, u.run)
+	// fetch latest lists before next schedule, a failure is recorded in the status and retried by the schedule
+	_ = v.refresher.refresh(ctx)
+	v.refresher.start(ctx)
+	return v
+}
+
+// DisableAutoUpdate stops previously started automatic updates,
+// it waits for an update in progress to return
+func (v *Verifier) DisableAutoUpdate() *Verifier {
+	v.stopCurrentSchedule()
+	return v
+}
+
+// AutoUpdateStatus returns the status of the automatic update of the metadata lists
+func (v *Verifier) AutoUpdateStatus() RefreshStatus {
+	if v.refresher == nil {
+		return RefreshStatus{}
+	}
+	return v.refresher.status()
+}
+
+// UseSyntaxProfile selects the syntax profile used to validate email addresses,
+// name is either one of the built-in profiles such as SyntaxRFC5321 or a profile added via RegisterSyntaxProfile.
+// An unknown name is reported by Err.
+func (v *Verifier) UseSyntaxProfile(name string) *Verifier {
+	profile, ok := lookupSyntaxProfile(name)
+	if !ok {
+		return v.fail(fmt.Errorf("unknown syntax profile: %s", name))
+	}
+	v.syntaxProfile = profile
+	return v
+}
+
+// Err returns the errors of the configuration methods, such as an unknown syntax profile,
+// Verify fails with them rather than verifying addresses with a half-applied configuration.
+func (v *Verifier) Err() error {
+	return v.configErr
+}
+
+// fail records a configuration error, returned by Err and Verify
+func (v *Verifier) fail(err error) *Verifier {
+	v.configErr = errors.Join(v.configErr, err)
 	return v
 }
 
@@ -324,10 +472,10 @@ func (v *Verifier) calculateReachable(s *SMTP) string {
 	return reachableNo
 }
 
-// stopCurrentSchedule stops current running schedule (if exists)
+// stopCurrentSchedule stops current running refresher (if exists)
 func (v *Verifier) stopCurrentSchedule() {
-	if v.schedule != nil {
-		v.schedule.stop()
+	if v.refresher != nil {
+		v.refresher.stop()
 	}
 }
 
@@ -20,9 +20,11 @@ func TestCheckEmailOK_SMTPHostNotExists(t *testing.T) {
 	expected := Result{
 		Email: email,
 		Syntax: Syntax{
-			Username: username,
-			Domain:   domain,
-			Valid:    true,
+			Username:          username,
+			Domain:            domain,
+			Valid:             true,
+			RegistrableDomain: domain,
+			PublicSuffix:      "com",
 		},
 		HasMxRecords: false,
 		Disposable:   false,
@@ -49,9 +51,11 @@ func TestCheckEmailOK_SMTPHostExists_NotCatchAll(t *testing.T) {
 	expected := Result{
 		Email: email,
 		Syntax: Syntax{
-			Username: username,
-			Domain:   domain,
-			Valid:    true,
+			Username:          username,
+			Domain:            domain,
+			Valid:             true,
+			RegistrableDomain: domain,
+			PublicSuffix:      "com",
 		},
 		HasMxRecords: true,
 		Reachable:    reachableUnknown,
@@ -84,9 +88,11 @@ func TestCheckEmailOK_SMTPHostExists_FreeDomain(t *testing.T) {
 	expected := Result{
 		Email: email,
 		Syntax: Syntax{
-			Username: username,
-			Domain:   domain,
-			Valid:    true,
+			Username:          username,
+			Domain:            domain,
+			Valid:             true,
+			RegistrableDomain: domain,
+			PublicSuffix:      "com",
 		},
 		HasMxRecords: true,
 		Reachable:    reachableNo,
@@ -148,9 +154,11 @@ func TestCheckEmail_Disposable(t *testing.T) {
 	expected := Result{
 		Email: email,
 		Syntax: Syntax{
-			Username: username,
-			Domain:   domain,
-			Valid:    true,
+			Username:          username,
+			Domain:            domain,
+			Valid:             true,
+			RegistrableDomain: domain,
+			PublicSuffix:      "shop",
 		},
 		HasMxRecords: false,
 		Reachable:    reachableUnknown,
@@ -177,9 +185,11 @@ func TestCheckEmail_Disposable_override(t *testing.T) {
 	expected := Result{
 		Email: email,
 		Syntax: Syntax{
-			Username: username,
-			Domain:   domain,
-			Valid:    true,
+			Username:          username,
+			Domain:            domain,
+			Valid:             true,
+			RegistrableDomain: domain,
+			PublicSuffix:      "de",
 		},
 		HasMxRecords: false,
 		Reachable:    reachableUnknown,
@@ -235,9 +245,11 @@ func TestCheckEmail_RoleAccount(t *testing.T) {
 	expected := Result{
 		Email: email,
 		Syntax: Syntax{
-			Username: username,
-			Domain:   domain,
-			Valid:    true,
+			Username:          username,
+			Domain:            domain,
+			Valid:             true,
+			RegistrableDomain: domain,
+			PublicSuffix:      "com",
 		},
 		HasMxRecords: true,
 		Reachable:    reachableUnknown,
@@ -271,9 +283,11 @@ func TestCheckEmail_DisabledSMTPCheck(t *testing.T) {
 	expected := Result{
 		Email: email,
 		Syntax: Syntax{
-			Username: username,
-			Domain:   domain,
-			Valid:    true,
+			Username:          username,
+			Domain:            domain,
+			Valid:             true,
+			RegistrableDomain: domain,
+			PublicSuffix:      "com",
 		},
 		HasMxRecords: true,
 		Disposable:   false,
@@ -308,7 +322,7 @@ func TestNewVerifierOK_AutoUpdateDisposableDuplicate(t *testing.T) {
 }
 
 func TestStopCurrentSchedule_ScheduleIsNil(t *testing.T) {
-	verifier.schedule = nil
+	verifier.refresher = nil
 	verifier.stopCurrentSchedule()
 }
 